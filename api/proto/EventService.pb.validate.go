@@ -96,6 +96,94 @@ func (m *Event) Validate() error {
 
 	}
 
+	// no validation rules for Rrule
+
+	// no validation rules for RecurringEventId
+
+	if v, ok := interface{}(m.GetRecurrenceId()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "RecurrenceId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetExdates() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  fmt.Sprintf("Exdates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for TimeZone
+
+	// no validation rules for AllDay
+
+	// no validation rules for Id
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Processed
+
+	for idx, item := range m.GetAttendees() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventValidationError{
+					field:  fmt.Sprintf("Attendees[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AllowOverlap
+
+	for idx, item := range m.GetReminderMinutes() {
+		_, _ = idx, item
+
+		if val := item; val < 0 || val > 40320 {
+			return EventValidationError{
+				field:  fmt.Sprintf("ReminderMinutes[%v]", idx),
+				reason: "value must be inside range [0, 40320]",
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -153,6 +241,88 @@ var _ interface {
 	ErrorName() string
 } = EventValidationError{}
 
+// Validate checks the field values on Attendee with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Attendee) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		return AttendeeValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Email
+
+	if _, ok := AttendeeRole_name[int32(m.GetRole())]; !ok {
+		return AttendeeValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for Status
+
+	return nil
+}
+
+// AttendeeValidationError is the validation error returned by
+// Attendee.Validate if the designated constraints aren't met.
+type AttendeeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendeeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendeeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendeeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendeeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendeeValidationError) ErrorName() string { return "AttendeeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttendeeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendee.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendeeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendeeValidationError{}
+
 // Validate checks the field values on Events with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
 func (m *Events) Validate() error {
@@ -301,6 +471,79 @@ var _ interface {
 	ErrorName() string
 } = CreateEventResponseValidationError{}
 
+// Validate checks the field values on GetEventRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GetEventRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetUuid()) != 36 {
+		return GetEventRequestValidationError{
+			field:  "Uuid",
+			reason: "value length must be 36 runes",
+		}
+
+	}
+
+	return nil
+}
+
+// GetEventRequestValidationError is the validation error returned by
+// GetEventRequest.Validate if the designated constraints aren't met.
+type GetEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEventRequestValidationError) ErrorName() string { return "GetEventRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEventRequestValidationError{}
+
 // Validate checks the field values on UpdateEventRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -615,6 +858,8 @@ func (m *GetEventsByDayRequest) Validate() error {
 
 	// no validation rules for Offset
 
+	// no validation rules for TimeZone
+
 	return nil
 }
 
@@ -673,3 +918,1565 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetEventsByDayRequestValidationError{}
+
+// Validate checks the field values on ListEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListEventsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetFrom() == nil {
+		return ListEventsRequestValidationError{
+			field:  "From",
+			reason: "value is required",
+		}
+	}
+
+	if m.GetTo() == nil {
+		return ListEventsRequestValidationError{
+			field:  "To",
+			reason: "value is required",
+		}
+	}
+
+	if m.GetPageSize() < 0 {
+		return ListEventsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	// no validation rules for PageToken
+
+	return nil
+}
+
+// ListEventsRequestValidationError is the validation error returned by
+// ListEventsRequest.Validate if the designated constraints aren't met.
+type ListEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsRequestValidationError) ErrorName() string {
+	return "ListEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsRequestValidationError{}
+
+// Validate checks the field values on ListEventsByPeriodRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListEventsByPeriodRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetDay()) != 10 {
+		return ListEventsByPeriodRequestValidationError{
+			field:  "Day",
+			reason: "value length must be 10 runes",
+		}
+
+	}
+
+	// no validation rules for TimeZone
+
+	if m.GetPageSize() < 0 {
+		return ListEventsByPeriodRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	// no validation rules for PageToken
+
+	return nil
+}
+
+// ListEventsByPeriodRequestValidationError is the validation error returned by
+// ListEventsByPeriodRequest.Validate if the designated constraints aren't met.
+type ListEventsByPeriodRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsByPeriodRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsByPeriodRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsByPeriodRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsByPeriodRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsByPeriodRequestValidationError) ErrorName() string {
+	return "ListEventsByPeriodRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsByPeriodRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsByPeriodRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsByPeriodRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsByPeriodRequestValidationError{}
+
+// Validate checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListEventsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEventsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	return nil
+}
+
+// ListEventsResponseValidationError is the validation error returned by
+// ListEventsResponse.Validate if the designated constraints aren't met.
+type ListEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsResponseValidationError) ErrorName() string {
+	return "ListEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsResponseValidationError{}
+
+// Validate checks the field values on InviteAttendeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *InviteAttendeeRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetUuid()) != 36 {
+		return InviteAttendeeRequestValidationError{
+			field:  "Uuid",
+			reason: "value length must be 36 runes",
+		}
+
+	}
+
+	if m.GetAttendee() == nil {
+		return InviteAttendeeRequestValidationError{
+			field:  "Attendee",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetAttendee()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InviteAttendeeRequestValidationError{
+				field:  "Attendee",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// InviteAttendeeRequestValidationError is the validation error returned by
+// InviteAttendeeRequest.Validate if the designated constraints aren't met.
+type InviteAttendeeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteAttendeeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteAttendeeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteAttendeeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteAttendeeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteAttendeeRequestValidationError) ErrorName() string {
+	return "InviteAttendeeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteAttendeeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteAttendeeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteAttendeeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteAttendeeRequestValidationError{}
+
+// Validate checks the field values on InviteAttendeeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *InviteAttendeeResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// InviteAttendeeResponseValidationError is the validation error returned by
+// InviteAttendeeResponse.Validate if the designated constraints aren't met.
+type InviteAttendeeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteAttendeeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteAttendeeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteAttendeeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteAttendeeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteAttendeeResponseValidationError) ErrorName() string {
+	return "InviteAttendeeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteAttendeeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteAttendeeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteAttendeeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteAttendeeResponseValidationError{}
+
+// Validate checks the field values on RemoveAttendeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveAttendeeRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetUuid()) != 36 {
+		return RemoveAttendeeRequestValidationError{
+			field:  "Uuid",
+			reason: "value length must be 36 runes",
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		return RemoveAttendeeRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// RemoveAttendeeRequestValidationError is the validation error returned by
+// RemoveAttendeeRequest.Validate if the designated constraints aren't met.
+type RemoveAttendeeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveAttendeeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveAttendeeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveAttendeeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveAttendeeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveAttendeeRequestValidationError) ErrorName() string {
+	return "RemoveAttendeeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveAttendeeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveAttendeeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveAttendeeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveAttendeeRequestValidationError{}
+
+// Validate checks the field values on RemoveAttendeeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RemoveAttendeeResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// RemoveAttendeeResponseValidationError is the validation error returned by
+// RemoveAttendeeResponse.Validate if the designated constraints aren't met.
+type RemoveAttendeeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveAttendeeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveAttendeeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveAttendeeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveAttendeeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveAttendeeResponseValidationError) ErrorName() string {
+	return "RemoveAttendeeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveAttendeeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveAttendeeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveAttendeeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveAttendeeResponseValidationError{}
+
+// Validate checks the field values on RespondToInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RespondToInvitationRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetUuid()) != 36 {
+		return RespondToInvitationRequestValidationError{
+			field:  "Uuid",
+			reason: "value length must be 36 runes",
+		}
+
+	}
+
+	if _, ok := RsvpStatus_name[int32(m.GetStatus())]; !ok {
+		return RespondToInvitationRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	return nil
+}
+
+// RespondToInvitationRequestValidationError is the validation error returned
+// by RespondToInvitationRequest.Validate if the designated constraints aren't met.
+type RespondToInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RespondToInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RespondToInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RespondToInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RespondToInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RespondToInvitationRequestValidationError) ErrorName() string {
+	return "RespondToInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RespondToInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRespondToInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RespondToInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RespondToInvitationRequestValidationError{}
+
+// Validate checks the field values on RespondToInvitationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RespondToInvitationResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// RespondToInvitationResponseValidationError is the validation error returned
+// by RespondToInvitationResponse.Validate if the designated constraints
+// aren't met.
+type RespondToInvitationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RespondToInvitationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RespondToInvitationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RespondToInvitationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RespondToInvitationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RespondToInvitationResponseValidationError) ErrorName() string {
+	return "RespondToInvitationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RespondToInvitationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRespondToInvitationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RespondToInvitationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RespondToInvitationResponseValidationError{}
+
+// Validate checks the field values on FreeBusyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *FreeBusyRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := len(m.GetUserIds()); l < 1 || l > 50 {
+		return FreeBusyRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 50 items, inclusive",
+		}
+	}
+
+	if m.GetFrom() == nil {
+		return FreeBusyRequestValidationError{
+			field:  "From",
+			reason: "value is required",
+		}
+	}
+
+	if m.GetTo() == nil {
+		return FreeBusyRequestValidationError{
+			field:  "To",
+			reason: "value is required",
+		}
+	}
+
+	return nil
+}
+
+// FreeBusyRequestValidationError is the validation error returned by
+// FreeBusyRequest.Validate if the designated constraints aren't met.
+type FreeBusyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreeBusyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreeBusyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreeBusyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreeBusyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreeBusyRequestValidationError) ErrorName() string { return "FreeBusyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FreeBusyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreeBusyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreeBusyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreeBusyRequestValidationError{}
+
+// Validate checks the field values on TimeInterval with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *TimeInterval) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeIntervalValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFinish()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeIntervalValidationError{
+				field:  "Finish",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// TimeIntervalValidationError is the validation error returned by
+// TimeInterval.Validate if the designated constraints aren't met.
+type TimeIntervalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeIntervalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeIntervalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeIntervalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeIntervalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeIntervalValidationError) ErrorName() string { return "TimeIntervalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeIntervalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeInterval.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeIntervalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeIntervalValidationError{}
+
+// Validate checks the field values on UserBusy with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *UserBusy) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	for idx, item := range m.GetBusy() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserBusyValidationError{
+					field:  fmt.Sprintf("Busy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// UserBusyValidationError is the validation error returned by
+// UserBusy.Validate if the designated constraints aren't met.
+type UserBusyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserBusyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserBusyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserBusyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserBusyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserBusyValidationError) ErrorName() string { return "UserBusyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserBusyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserBusy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserBusyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserBusyValidationError{}
+
+// Validate checks the field values on FreeBusyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *FreeBusyResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FreeBusyResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// FreeBusyResponseValidationError is the validation error returned by
+// FreeBusyResponse.Validate if the designated constraints aren't met.
+type FreeBusyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreeBusyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreeBusyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreeBusyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreeBusyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreeBusyResponseValidationError) ErrorName() string { return "FreeBusyResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FreeBusyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreeBusyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreeBusyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreeBusyResponseValidationError{}
+
+// Validate checks the field values on FindAvailableSlotsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FindAvailableSlotsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := len(m.GetUserIds()); l < 1 || l > 50 {
+		return FindAvailableSlotsRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 50 items, inclusive",
+		}
+	}
+
+	if m.GetDurationMinutes() <= 0 {
+		return FindAvailableSlotsRequestValidationError{
+			field:  "DurationMinutes",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetFrom() == nil {
+		return FindAvailableSlotsRequestValidationError{
+			field:  "From",
+			reason: "value is required",
+		}
+	}
+
+	if m.GetTo() == nil {
+		return FindAvailableSlotsRequestValidationError{
+			field:  "To",
+			reason: "value is required",
+		}
+	}
+
+	// no validation rules for WorkingHoursStart
+
+	// no validation rules for WorkingHoursEnd
+
+	// no validation rules for TimeZone
+
+	if m.GetMaxResults() < 0 {
+		return FindAvailableSlotsRequestValidationError{
+			field:  "MaxResults",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	return nil
+}
+
+// FindAvailableSlotsRequestValidationError is the validation error returned by
+// FindAvailableSlotsRequest.Validate if the designated constraints aren't met.
+type FindAvailableSlotsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindAvailableSlotsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindAvailableSlotsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindAvailableSlotsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindAvailableSlotsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindAvailableSlotsRequestValidationError) ErrorName() string {
+	return "FindAvailableSlotsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindAvailableSlotsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindAvailableSlotsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindAvailableSlotsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindAvailableSlotsRequestValidationError{}
+
+// Validate checks the field values on FindAvailableSlotsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FindAvailableSlotsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetSlots() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FindAvailableSlotsResponseValidationError{
+					field:  fmt.Sprintf("Slots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// FindAvailableSlotsResponseValidationError is the validation error returned
+// by FindAvailableSlotsResponse.Validate if the designated constraints aren't met.
+type FindAvailableSlotsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindAvailableSlotsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindAvailableSlotsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindAvailableSlotsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindAvailableSlotsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindAvailableSlotsResponseValidationError) ErrorName() string {
+	return "FindAvailableSlotsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindAvailableSlotsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindAvailableSlotsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindAvailableSlotsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindAvailableSlotsResponseValidationError{}
+
+// Validate checks the field values on UpdateOccurrenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateOccurrenceRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetUuid()) != 36 {
+		return UpdateOccurrenceRequestValidationError{
+			field:  "Uuid",
+			reason: "value length must be 36 runes",
+		}
+
+	}
+
+	if m.GetRecurrenceId() == nil {
+		return UpdateOccurrenceRequestValidationError{
+			field:  "RecurrenceId",
+			reason: "value is required",
+		}
+	}
+
+	if _, ok := OccurrenceScope_name[int32(m.GetScope())]; !ok {
+		return UpdateOccurrenceRequestValidationError{
+			field:  "Scope",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOccurrenceRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateOccurrenceRequestValidationError is the validation error returned by
+// UpdateOccurrenceRequest.Validate if the designated constraints aren't met.
+type UpdateOccurrenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOccurrenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOccurrenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOccurrenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOccurrenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOccurrenceRequestValidationError) ErrorName() string {
+	return "UpdateOccurrenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOccurrenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOccurrenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOccurrenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOccurrenceRequestValidationError{}
+
+// Validate checks the field values on UpdateOccurrenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateOccurrenceResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// UpdateOccurrenceResponseValidationError is the validation error returned by
+// UpdateOccurrenceResponse.Validate if the designated constraints aren't met.
+type UpdateOccurrenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOccurrenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOccurrenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOccurrenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOccurrenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOccurrenceResponseValidationError) ErrorName() string {
+	return "UpdateOccurrenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOccurrenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOccurrenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOccurrenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOccurrenceResponseValidationError{}
+
+// Validate checks the field values on DeleteOccurrenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteOccurrenceRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetUuid()) != 36 {
+		return DeleteOccurrenceRequestValidationError{
+			field:  "Uuid",
+			reason: "value length must be 36 runes",
+		}
+
+	}
+
+	if m.GetRecurrenceId() == nil {
+		return DeleteOccurrenceRequestValidationError{
+			field:  "RecurrenceId",
+			reason: "value is required",
+		}
+	}
+
+	if _, ok := OccurrenceScope_name[int32(m.GetScope())]; !ok {
+		return DeleteOccurrenceRequestValidationError{
+			field:  "Scope",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	return nil
+}
+
+// DeleteOccurrenceRequestValidationError is the validation error returned by
+// DeleteOccurrenceRequest.Validate if the designated constraints aren't met.
+type DeleteOccurrenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteOccurrenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteOccurrenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteOccurrenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteOccurrenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteOccurrenceRequestValidationError) ErrorName() string {
+	return "DeleteOccurrenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteOccurrenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteOccurrenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteOccurrenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteOccurrenceRequestValidationError{}
+
+// Validate checks the field values on DeleteOccurrenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteOccurrenceResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeleteOccurrenceResponseValidationError is the validation error returned by
+// DeleteOccurrenceResponse.Validate if the designated constraints aren't met.
+type DeleteOccurrenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteOccurrenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteOccurrenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteOccurrenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteOccurrenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteOccurrenceResponseValidationError) ErrorName() string {
+	return "DeleteOccurrenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteOccurrenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteOccurrenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteOccurrenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteOccurrenceResponseValidationError{}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+
+	"github.com/seregproj/calendar/internal/auth"
+)
+
+var ErrNoAuthConfigured = errors.New("no authentication configured")
+
+func newAuthenticator(config Auth) (auth.Authenticator, error) {
+	chain := auth.Chain{}
+
+	rsaKeys := make(map[string]*rsa.PublicKey)
+	if config.JWT.JWKSFile != "" {
+		keys, err := auth.LoadJWKS(config.JWT.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("cant load jwks: %w", err)
+		}
+
+		rsaKeys = keys
+	}
+
+	if config.JWT.RSAPublicKey != "" {
+		key, err := auth.ParseRSAPublicKey([]byte(config.JWT.RSAPublicKey))
+		if err != nil {
+			return nil, fmt.Errorf("cant parse rsa public key: %w", err)
+		}
+
+		rsaKeys[""] = key
+	}
+
+	if config.JWT.HMACSecret != "" || len(rsaKeys) > 0 {
+		chain = append(chain, auth.NewJWTAuthenticator([]byte(config.JWT.HMACSecret), rsaKeys, config.JWT.Issuer,
+			config.JWT.Audience))
+	}
+
+	if len(config.APIKeys) > 0 {
+		chain = append(chain, auth.NewAPIKeyAuthenticator(config.APIKeys))
+	}
+
+	if len(chain) == 0 {
+		return nil, ErrNoAuthConfigured
+	}
+
+	return chain, nil
+}
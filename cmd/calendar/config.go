@@ -4,6 +4,7 @@ type Config struct {
 	Logger  Logger
 	Server  ServerConf
 	Storage Storage
+	Auth    Auth
 }
 
 type Logger struct {
@@ -35,6 +36,19 @@ type PGSQL struct {
 	DSN string `yaml:"dsn" env:"PGSQL_DSN"`
 }
 
+type Auth struct {
+	JWT     JWT
+	APIKeys map[string]string `yaml:"apiKeys" env:"AUTH_API_KEYS"`
+}
+
+type JWT struct {
+	HMACSecret   string `yaml:"hmacSecret" env:"AUTH_JWT_HMAC_SECRET"`
+	RSAPublicKey string `yaml:"rsaPublicKey" env:"AUTH_JWT_RSA_PUBLIC_KEY"`
+	JWKSFile     string `yaml:"jwksFile" env:"AUTH_JWT_JWKS_FILE"`
+	Issuer       string `yaml:"issuer" env:"AUTH_JWT_ISSUER"`
+	Audience     string `yaml:"audience" env:"AUTH_JWT_AUDIENCE"`
+}
+
 func NewConfig() Config {
 	return Config{}
 }
@@ -10,6 +10,7 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // IANA time zones of events are needed in images without system tzdata
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/ilyakaznacheev/cleanenv"
@@ -70,12 +71,20 @@ func main() {
 		os.Exit(1)
 	}
 
+	authenticator, err := newAuthenticator(config.Auth)
+	if err != nil {
+		fmt.Println(fmt.Errorf("cant create authenticator: %w", err))
+		os.Exit(1) //nolint:gocritic
+	}
+
 	calendar := calendarapp.New(logger, storage, internalstorage.NewUUIDGen())
 	eventsService := internalgrpc.NewEventServer(calendar)
+	grpcAddr := net.JoinHostPort(config.Server.GRPC.Host, config.Server.GRPC.Port)
 
-	// HTTP
-	mux := runtime.NewServeMux()
-	if err = proto.RegisterEventServiceHandlerServer(ctx, mux, eventsService); err != nil {
+	// HTTP, requests go through GRPC server to be authenticated by its interceptors
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(internalgrpc.IncomingHeaderMatcher))
+	if err = proto.RegisterEventServiceHandlerFromEndpoint(ctx, mux, grpcAddr,
+		[]grpc.DialOption{grpc.WithInsecure()}); err != nil {
 		logger.Error(fmt.Sprintf("cant register service handler: %v", err))
 
 		return
@@ -95,13 +104,13 @@ func main() {
 	}()
 
 	// GRPC
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(internalgrpc.AuthUnaryInterceptor(authenticator)))
 
 	go func() {
 		defer cancel()
 
 		proto.RegisterEventServiceServer(grpcServer, eventsService)
-		l, err := net.Listen("tcp", net.JoinHostPort(config.Server.GRPC.Host, config.Server.GRPC.Port))
+		l, err := net.Listen("tcp", grpcAddr)
 		if err != nil {
 			logger.Error(fmt.Sprintf("cant get grpc listener: %v", err))
 
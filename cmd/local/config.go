@@ -0,0 +1,94 @@
+package main
+
+import "time"
+
+type Config struct {
+	Logger    Logger
+	Server    ServerConf
+	Auth      Auth
+	App       App
+	Retry     Retry
+	Channels  Channels
+	Templates Templates
+}
+
+type Logger struct {
+	Level string `yaml:"level" env:"LOG_LEVEL" env-default:"info"`
+	File  string `yaml:"file" env:"LOG_FILE"`
+}
+
+type ServerConf struct {
+	GRPC GRPC
+	HTTP HTTP
+}
+
+type HTTP struct {
+	Host string `yaml:"host" env:"HTTP_HOST" env-default:"127.0.0.1"`
+	Port string `yaml:"port" env:"HTTP_PORT" env-default:"8888"`
+}
+
+type GRPC struct {
+	Host string `yaml:"host" env:"GRPC_HOST" env-default:"127.0.0.1"`
+	Port string `yaml:"port" env:"GRPC_PORT" env-default:"8889"`
+}
+
+type Auth struct {
+	// APIKeys maps API keys to user ids
+	APIKeys map[string]string `yaml:"apiKeys" env:"AUTH_API_KEYS"`
+}
+
+type App struct {
+	Notifications Notifications
+}
+
+type Notifications struct {
+	Limit    int64         `yaml:"limit" env:"APP_NOTIFICATIONS_LIMIT" env-default:"100"`
+	Interval time.Duration `yaml:"interval" env:"APP_NOTIFICATIONS_INTERVAL" env-default:"1m"`
+	// Lease hides claimed reminders, unsent ones are retried after it
+	Lease time.Duration `yaml:"lease" env:"APP_NOTIFICATIONS_LEASE" env-default:"5m"`
+	// MaxDelay is how late reminders may be sent, older ones are skipped and counted
+	MaxDelay time.Duration `yaml:"maxDelay" env:"APP_NOTIFICATIONS_MAX_DELAY" env-default:"1h"`
+}
+
+type Retry struct {
+	// MaxAttempts of delivery before the notification is moved to dead letters
+	MaxAttempts int `yaml:"maxAttempts" env:"MESSAGEBROKER_RETRY_MAX_ATTEMPTS" env-default:"5"`
+	// Backoff after the first failed attempt, it doubles after every next one up to MaxBackoff
+	Backoff    time.Duration `yaml:"backoff" env:"MESSAGEBROKER_RETRY_BACKOFF" env-default:"30s"`
+	MaxBackoff time.Duration `yaml:"maxBackoff" env:"MESSAGEBROKER_RETRY_MAX_BACKOFF" env-default:"30m"`
+}
+
+type Channels struct {
+	// Users maps user id to preferences of the user
+	Users   map[string]User `yaml:"users"`
+	Webhook Webhook         `yaml:"webhook"`
+	File    File            `yaml:"file"`
+}
+
+type User struct {
+	Channels []string `yaml:"channels"`
+	Locale   string   `yaml:"locale"`
+	TimeZone string   `yaml:"timeZone"`
+}
+
+type Webhook struct {
+	URL     string        `yaml:"url" env:"WEBHOOK_URL"`
+	Timeout time.Duration `yaml:"timeout" env:"WEBHOOK_TIMEOUT" env-default:"10s"`
+}
+
+type File struct {
+	// Path of the file notifications are appended to, stdout when empty or "-"
+	Path string `yaml:"path" env:"FILE_PATH"`
+}
+
+type Templates struct {
+	// Dir holds templates as <channel>/<locale>.txt or <channel>/<locale>.html
+	Dir           string `yaml:"dir" env:"TEMPLATES_DIR" env-default:"./templates"`
+	DefaultLocale string `yaml:"defaultLocale" env:"TEMPLATES_DEFAULT_LOCALE" env-default:"en"`
+	// EventURL is format of links to events with %s for event id
+	EventURL string `yaml:"eventURL" env:"TEMPLATES_EVENT_URL"`
+}
+
+func NewConfig() Config {
+	return Config{}
+}
@@ -0,0 +1,206 @@
+// Command local runs the calendar API, the scheduler and the sender in one process, they share the memory storage
+// and the memory broker, so the whole pipeline runs locally without Postgres and RabbitMQ. Events and
+// notifications are lost on exit.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+	_ "time/tzdata" // IANA time zones of events are needed in images without system tzdata
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/ilyakaznacheev/cleanenv"
+	"github.com/seregproj/calendar/api/proto"
+	calendarapp "github.com/seregproj/calendar/internal/app/calendar"
+	schedulerapp "github.com/seregproj/calendar/internal/app/scheduler"
+	senderapp "github.com/seregproj/calendar/internal/app/sender"
+	"github.com/seregproj/calendar/internal/auth"
+	"github.com/seregproj/calendar/internal/channel"
+	internallogger "github.com/seregproj/calendar/internal/logger"
+	"github.com/seregproj/calendar/internal/messagebroker"
+	memorybroker "github.com/seregproj/calendar/internal/messagebroker/memory"
+	internalgrpc "github.com/seregproj/calendar/internal/server/grpc"
+	internalstorage "github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	var configFile string
+	flag.StringVar(&configFile, "config", "./configs/local_config.yml", "Path to configuration file")
+	flag.Parse()
+
+	config := NewConfig()
+	err := cleanenv.ReadConfig(configFile, &config)
+	if err != nil {
+		fmt.Println(fmt.Errorf("cant read config: %w", err))
+		os.Exit(1)
+	}
+
+	lvl, err := internallogger.NewLevel(config.Logger.Level)
+	if err != nil {
+		fmt.Println(fmt.Errorf("cant create log level: %w", err))
+		os.Exit(1)
+	}
+
+	logger, err := internallogger.New(config.Logger.File, lvl)
+	if err != nil {
+		fmt.Println(fmt.Errorf("cant create log: %w", err))
+		os.Exit(1)
+	}
+
+	if len(config.Auth.APIKeys) == 0 {
+		fmt.Println("no api keys configured")
+		os.Exit(1)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, os.Kill, syscall.SIGTERM, syscall.SIGHUP)
+	defer cancel()
+
+	storage := memorystorage.New()
+
+	broker, err := memorybroker.New(messagebroker.RetryPolicy{
+		MaxAttempts: config.Retry.MaxAttempts,
+		Backoff:     config.Retry.Backoff,
+		MaxBackoff:  config.Retry.MaxBackoff,
+	})
+	if err != nil {
+		fmt.Println("cant create memory broker: ", err)
+
+		return
+	}
+
+	fileChannel, err := channel.NewFile(config.Channels.File.Path)
+	if err != nil {
+		fmt.Println("cant create file channel: ", err)
+
+		return
+	}
+
+	defer fileChannel.Close()
+
+	channels := []senderapp.Channel{fileChannel}
+	if config.Channels.Webhook.URL != "" {
+		channels = append(channels, channel.NewWebhook(config.Channels.Webhook.URL, config.Channels.Webhook.Timeout))
+	}
+
+	users := make(map[string]senderapp.Recipient, len(config.Channels.Users))
+	for userID, u := range config.Channels.Users {
+		users[userID] = senderapp.Recipient{Channels: u.Channels, Locale: u.Locale, TimeZone: u.TimeZone}
+	}
+
+	router, err := senderapp.NewRouter(channels, []string{fileChannel.Name()}, users)
+	if err != nil {
+		fmt.Println("cant create channel router: ", err)
+
+		return
+	}
+
+	templates, err := senderapp.LoadTemplates(config.Templates.Dir, config.Templates.DefaultLocale,
+		config.Templates.EventURL, router.Names())
+	if err != nil {
+		fmt.Println("cant load templates: ", err)
+
+		return
+	}
+
+	sender := senderapp.New(logger, broker, router, templates)
+
+	schedule, err := schedulerapp.NewSchedule(config.App.Notifications.Interval, "")
+	if err != nil {
+		fmt.Println(fmt.Errorf("cant create schedule: %w", err))
+
+		return
+	}
+
+	scheduler := schedulerapp.New(logger, storage, broker, schedulerapp.SystemClock{},
+		config.App.Notifications.Lease, config.App.Notifications.MaxDelay)
+
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		if err := scheduler.Run(ctx, schedule, config.App.Notifications.Limit); err != nil {
+			fmt.Println("cant run scheduler: ", err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		defer cancel()
+
+		if err := sender.SendNotifications(ctx); err != nil {
+			fmt.Println("cant send notifications: ", err)
+		}
+	}()
+
+	calendar := calendarapp.New(logger, storage, internalstorage.NewUUIDGen())
+	grpcAddr := net.JoinHostPort(config.Server.GRPC.Host, config.Server.GRPC.Port)
+
+	// HTTP, requests go through GRPC server to be authenticated by its interceptors
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(internalgrpc.IncomingHeaderMatcher))
+	if err = proto.RegisterEventServiceHandlerFromEndpoint(ctx, mux, grpcAddr,
+		[]grpc.DialOption{grpc.WithInsecure()}); err != nil {
+		logger.Error(fmt.Sprintf("cant register service handler: %v", err))
+
+		return
+	}
+
+	httpServer := http.Server{
+		Addr:    net.JoinHostPort(config.Server.HTTP.Host, config.Server.HTTP.Port),
+		Handler: mux,
+	}
+
+	go func() {
+		defer cancel()
+
+		if err = httpServer.ListenAndServe(); err != nil {
+			logger.Error(fmt.Sprintf("cant start HTTP server: %v", err))
+		}
+	}()
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(
+		internalgrpc.AuthUnaryInterceptor(auth.NewAPIKeyAuthenticator(config.Auth.APIKeys))))
+
+	go func() {
+		defer cancel()
+
+		proto.RegisterEventServiceServer(grpcServer, internalgrpc.NewEventServer(calendar))
+		l, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			logger.Error(fmt.Sprintf("cant get grpc listener: %v", err))
+
+			return
+		}
+
+		err = grpcServer.Serve(l)
+		if err != nil {
+			logger.Error(fmt.Sprintf("cant start GRPC server: %v", err))
+		}
+	}()
+
+	fmt.Println("calendar, scheduler and sender are running...")
+
+	<-ctx.Done()
+	fmt.Println("Graceful shutdown...")
+	wg.Wait()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err = httpServer.Shutdown(ctx); err != nil {
+		logger.Error(fmt.Sprintf("cant shutdown http server: %v", err))
+	}
+	grpcServer.Stop()
+}
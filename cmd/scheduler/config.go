@@ -1,10 +1,17 @@
 package main
 
+import (
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker/rbmq/notifications"
+)
+
 type Config struct {
 	Logger
 	Storage
 	MessageBroker
 	App
+	Metrics
 }
 
 type Logger struct {
@@ -22,20 +29,58 @@ type PGSQL struct {
 }
 
 type MessageBroker struct {
+	// Type is rabbitmq or file, the file broker runs the scheduler and the sender locally without a broker server,
+	// the memory one is supported only by cmd/local
+	Type string `yaml:"type" env:"MESSAGEBROKER_TYPE" env-default:"rabbitmq"`
 	RBMQ
+	File FileBroker
+}
+
+type FileBroker struct {
+	// Dir is spool directory shared with the sender
+	Dir string `yaml:"dir" env:"MESSAGEBROKER_FILE_DIR" env-default:"/var/spool/calendar"`
 }
 
 type RBMQ struct {
-	DSN       string `yaml:"dsn" env:"RBMQ_DSN"`
+	DSN string `yaml:"dsn" env:"RBMQ_DSN"`
+	// QueueName is the queue notifications are routed to unless queues of the topology are set
 	QueueName string `yaml:"queueName" env:"RBMQ_QUEUE_NAME"`
+	// ContentType of published notifications, application/json or application/x-protobuf
+	ContentType string `yaml:"contentType" env:"RBMQ_CONTENT_TYPE" env-default:"application/json"`
+	// ConfirmTimeout is how long publishing waits for confirmation of the broker
+	ConfirmTimeout time.Duration                `yaml:"confirmTimeout" env:"RBMQ_CONFIRM_TIMEOUT" env-default:"5s"`
+	Topology       notifications.TopologyConfig `yaml:"topology"`
 }
 
 type App struct {
 	Notifications
+	Retention
 }
 
 type Notifications struct {
-	Limit int64 `yaml:"limit" env:"APP_NOTIFICATIONS_LIMIT"`
+	Limit int64 `yaml:"limit" env:"APP_NOTIFICATIONS_LIMIT" env-default:"100"`
+	// Interval between runs is used unless Cron expression is set
+	Interval time.Duration `yaml:"interval" env:"APP_NOTIFICATIONS_INTERVAL" env-default:"1m"`
+	Cron     string        `yaml:"cron" env:"APP_NOTIFICATIONS_CRON"`
+	// Lease hides claimed reminders from other scheduler instances, unsent ones are retried after it
+	Lease time.Duration `yaml:"lease" env:"APP_NOTIFICATIONS_LEASE" env-default:"5m"`
+	// MaxDelay is how late reminders may be sent, older ones are skipped and counted
+	MaxDelay time.Duration `yaml:"maxDelay" env:"APP_NOTIFICATIONS_MAX_DELAY" env-default:"1h"`
+}
+
+type Retention struct {
+	// Days events are kept after their finish, purge is disabled when zero
+	Days    int   `yaml:"days" env:"APP_RETENTION_DAYS"`
+	Archive bool  `yaml:"archive" env:"APP_RETENTION_ARCHIVE"`
+	Limit   int64 `yaml:"limit" env:"APP_RETENTION_LIMIT" env-default:"1000"`
+	// Interval between purges is used unless Cron expression is set
+	Interval time.Duration `yaml:"interval" env:"APP_RETENTION_INTERVAL" env-default:"1h"`
+	Cron     string        `yaml:"cron" env:"APP_RETENTION_CRON"`
+}
+
+type Metrics struct {
+	// Addr serves expvar metrics at /debug/vars, disabled when empty
+	Addr string `yaml:"addr" env:"METRICS_ADDR"`
 }
 
 func NewConfig() Config {
@@ -2,16 +2,21 @@ package main
 
 import (
 	"context"
+	_ "expvar"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 	schedulerapp "github.com/seregproj/calendar/internal/app/scheduler"
 	internallogger "github.com/seregproj/calendar/internal/logger"
+	"github.com/seregproj/calendar/internal/messagebroker"
+	filebroker "github.com/seregproj/calendar/internal/messagebroker/file"
 	"github.com/seregproj/calendar/internal/messagebroker/rbmq/notifications"
 	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
 	sqlstorage "github.com/seregproj/calendar/internal/storage/sql"
@@ -66,29 +71,90 @@ func main() {
 		return
 	}
 
-	producerRbmq := notifications.NewProducer(config.MessageBroker.QueueName)
-	if err := producerRbmq.Connect(ctx, config.MessageBroker.DSN); err != nil {
-		fmt.Println("cant connect to rbmq: ", err)
+	var broker schedulerapp.MessageBroker
+	switch config.MessageBroker.Type {
+	case "rabbitmq":
+		topology := config.MessageBroker.Topology.Topology(config.MessageBroker.QueueName)
+		producerRbmq := notifications.NewProducer(topology, config.MessageBroker.ContentType,
+			config.MessageBroker.ConfirmTimeout)
+		if err := producerRbmq.Connect(ctx, config.MessageBroker.DSN); err != nil {
+			fmt.Println("cant connect to rbmq: ", err)
+
+			return
+		}
+
+		broker = producerRbmq
+	case "file":
+		// the scheduler only publishes, retries are made by the sender
+		fileBroker, err := filebroker.New(config.MessageBroker.File.Dir, messagebroker.RetryPolicy{MaxAttempts: 1}, 0)
+		if err != nil {
+			fmt.Println("cant create file broker: ", err)
+
+			return
+		}
+
+		broker = fileBroker
+	case "memory":
+		// notifications would never leave the process, only cmd/local connects the scheduler and the sender by it
+		fmt.Println("memory message broker is supported only by cmd/local")
+
+		return
+	default:
+		fmt.Println(fmt.Errorf("invalid message broker type: %s", config.MessageBroker.Type))
+
+		return
+	}
+
+	schedule, err := schedulerapp.NewSchedule(config.App.Notifications.Interval, config.App.Notifications.Cron)
+	if err != nil {
+		fmt.Println(fmt.Errorf("cant create schedule: %w", err))
 
 		return
 	}
 
-	scheduler := schedulerapp.New(logger, storage, producerRbmq)
+	scheduler := schedulerapp.New(logger, storage, broker, schedulerapp.SystemClock{},
+		config.App.Notifications.Lease, config.App.Notifications.MaxDelay)
+
+	if config.Metrics.Addr != "" {
+		go func() {
+			if err := http.ListenAndServe(config.Metrics.Addr, nil); err != nil { //nolint:gosec
+				fmt.Println("cant serve metrics: ", err)
+			}
+		}()
+	}
 
-	go func() {
-		defer cancel()
+	var wg sync.WaitGroup
 
-		if err := scheduler.ProcessActualEvents(ctx, config.App.Notifications.Limit); err != nil {
-			fmt.Println("cant process actual events: ", err)
+	if config.App.Retention.Days > 0 {
+		purgeSchedule, err := schedulerapp.NewSchedule(config.App.Retention.Interval, config.App.Retention.Cron)
+		if err != nil {
+			fmt.Println(fmt.Errorf("cant create purge schedule: %w", err))
 
 			return
 		}
 
-		fmt.Println("successfully processed")
-	}()
+		policy := schedulerapp.RetentionPolicy{
+			Age:     time.Duration(config.App.Retention.Days) * 24 * time.Hour,
+			Archive: config.App.Retention.Archive,
+		}
+
+		wg.Add(1)
 
-	<-ctx.Done()
+		go func() {
+			defer wg.Done()
+
+			if err := scheduler.RunPurge(ctx, purgeSchedule, policy, config.App.Retention.Limit); err != nil {
+				fmt.Println("cant run purge: ", err)
+			}
+		}()
+	}
+
+	fmt.Println("scheduler is running...")
+
+	if err := scheduler.Run(ctx, schedule, config.App.Notifications.Limit); err != nil {
+		fmt.Println("cant run scheduler: ", err)
+	}
+
+	wg.Wait()
 	fmt.Println("Graceful shutdown...")
-	ctx, cancel = context.WithTimeout(context.Background(), 3*time.Second)
-	defer cancel()
 }
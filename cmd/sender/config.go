@@ -1,8 +1,16 @@
 package main
 
+import (
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker/rbmq/notifications"
+)
+
 type Config struct {
 	Logger
 	MessageBroker
+	Channels
+	Templates
 }
 
 type Logger struct {
@@ -11,12 +19,99 @@ type Logger struct {
 }
 
 type MessageBroker struct {
+	// Type is rabbitmq or file, the file broker runs the scheduler and the sender locally without a broker server,
+	// the memory one is supported only by cmd/local
+	Type string `yaml:"type" env:"MESSAGEBROKER_TYPE" env-default:"rabbitmq"`
 	RBMQ
+	File  FileBroker
+	Retry Retry
 }
 
 type RBMQ struct {
-	DSN       string `yaml:"dsn" env:"RBMQ_DSN"`
+	DSN string `yaml:"dsn" env:"RBMQ_DSN"`
+	// QueueName is the queue notifications are consumed from, it must be in queues of the topology when they are set
 	QueueName string `yaml:"queueName" env:"RBMQ_QUEUE_NAME"`
+	// ConfirmTimeout is how long publishing of a retry waits for confirmation of the broker
+	ConfirmTimeout time.Duration `yaml:"confirmTimeout" env:"RBMQ_CONFIRM_TIMEOUT" env-default:"5s"`
+	// Prefetch limits unacked notifications of the sender
+	Prefetch int                          `yaml:"prefetch" env:"RBMQ_PREFETCH" env-default:"10"`
+	Topology notifications.TopologyConfig `yaml:"topology"`
+}
+
+// topology returns topology of the broker limiting unacked notifications of the sender by prefetch.
+func (c RBMQ) topology() notifications.Topology {
+	t := c.Topology.Topology(c.QueueName)
+	t.Prefetch = c.Prefetch
+
+	return t
+}
+
+type FileBroker struct {
+	// Dir is spool directory shared with the scheduler
+	Dir          string        `yaml:"dir" env:"MESSAGEBROKER_FILE_DIR" env-default:"/var/spool/calendar"`
+	PollInterval time.Duration `yaml:"pollInterval" env:"MESSAGEBROKER_FILE_POLL_INTERVAL" env-default:"1s"`
+}
+
+type Retry struct {
+	// MaxAttempts of delivery before the notification is moved to dead letters
+	MaxAttempts int `yaml:"maxAttempts" env:"MESSAGEBROKER_RETRY_MAX_ATTEMPTS" env-default:"5"`
+	// Backoff after the first failed attempt, it doubles after every next one up to MaxBackoff
+	Backoff    time.Duration `yaml:"backoff" env:"MESSAGEBROKER_RETRY_BACKOFF" env-default:"30s"`
+	MaxBackoff time.Duration `yaml:"maxBackoff" env:"MESSAGEBROKER_RETRY_MAX_BACKOFF" env-default:"30m"`
+}
+
+type Channels struct {
+	// Default channels of users without preferences
+	Default []string `yaml:"default" env:"CHANNELS_DEFAULT" env-default:"file"`
+	// Only channels deliver notifications when set, e.g. by the sender of the queue of email notifications
+	Only []string `yaml:"only" env:"CHANNELS_ONLY"`
+	// Users maps user id to preferences of the user
+	Users   map[string]User `yaml:"users"`
+	SMTP    SMTP            `yaml:"smtp"`
+	Webhook Webhook         `yaml:"webhook"`
+	File    File            `yaml:"file"`
+}
+
+type User struct {
+	Channels []string `yaml:"channels"`
+	Locale   string   `yaml:"locale"`
+	TimeZone string   `yaml:"timeZone"`
+}
+
+type SMTP struct {
+	Addr     string `yaml:"addr" env:"SMTP_ADDR"`
+	From     string `yaml:"from" env:"SMTP_FROM" env-default:"calendar@localhost"`
+	Username string `yaml:"username" env:"SMTP_USERNAME"`
+	Password string `yaml:"password" env:"SMTP_PASSWORD"`
+	TLS      TLS    `yaml:"tls"`
+}
+
+// TLS of connections upgraded by STARTTLS, the server certificate is verified against system roots by default.
+type TLS struct {
+	// CAFile is PEM file of certificates the server certificate is verified against instead of system roots
+	CAFile string `yaml:"caFile" env:"SMTP_TLS_CA_FILE"`
+	// ServerName the certificate is verified for, host of addr when empty
+	ServerName string `yaml:"serverName" env:"SMTP_TLS_SERVER_NAME"`
+	// InsecureSkipVerify disables verification of the certificate, for testing only
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify" env:"SMTP_TLS_INSECURE_SKIP_VERIFY"`
+}
+
+type Webhook struct {
+	URL     string        `yaml:"url" env:"WEBHOOK_URL"`
+	Timeout time.Duration `yaml:"timeout" env:"WEBHOOK_TIMEOUT" env-default:"10s"`
+}
+
+type File struct {
+	// Path of the file notifications are appended to, stdout when empty or "-"
+	Path string `yaml:"path" env:"FILE_PATH"`
+}
+
+type Templates struct {
+	// Dir holds templates as <channel>/<locale>.txt or <channel>/<locale>.html
+	Dir           string `yaml:"dir" env:"TEMPLATES_DIR" env-default:"./templates"`
+	DefaultLocale string `yaml:"defaultLocale" env:"TEMPLATES_DEFAULT_LOCALE" env-default:"en"`
+	// EventURL is format of links to events with %s for event id
+	EventURL string `yaml:"eventURL" env:"TEMPLATES_EVENT_URL"`
 }
 
 func NewConfig() Config {
@@ -2,16 +2,23 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // time zones of recipients are needed in images without system tzdata
 
 	"github.com/ilyakaznacheev/cleanenv"
 	senderapp "github.com/seregproj/calendar/internal/app/sender"
+	"github.com/seregproj/calendar/internal/channel"
 	internallogger "github.com/seregproj/calendar/internal/logger"
+	"github.com/seregproj/calendar/internal/messagebroker"
+	filebroker "github.com/seregproj/calendar/internal/messagebroker/file"
 	"github.com/seregproj/calendar/internal/messagebroker/rbmq/notifications"
 )
 
@@ -42,14 +49,113 @@ func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, os.Kill, syscall.SIGTERM, syscall.SIGHUP)
 	defer cancel()
 
-	consumerRbmq := notifications.NewConsumer(config.MessageBroker.QueueName)
-	if err := consumerRbmq.Connect(ctx, config.MessageBroker.DSN); err != nil {
-		fmt.Println("cant connect to rbmq: ", err)
+	retry := messagebroker.RetryPolicy{
+		MaxAttempts: config.MessageBroker.Retry.MaxAttempts,
+		Backoff:     config.MessageBroker.Retry.Backoff,
+		MaxBackoff:  config.MessageBroker.Retry.MaxBackoff,
+	}
+
+	var broker senderapp.MessageBroker
+	switch config.MessageBroker.Type {
+	case "rabbitmq":
+		consumerRbmq := notifications.NewConsumer(config.MessageBroker.RBMQ.topology(), config.MessageBroker.QueueName,
+			retry, config.MessageBroker.ConfirmTimeout)
+		if err := consumerRbmq.Connect(ctx, config.MessageBroker.DSN); err != nil {
+			fmt.Println("cant connect to rbmq: ", err)
+
+			return
+		}
+
+		broker = consumerRbmq
+	case "file":
+		fileBroker, err := filebroker.New(config.MessageBroker.File.Dir, retry, config.MessageBroker.File.PollInterval)
+		if err != nil {
+			fmt.Println("cant create file broker: ", err)
+
+			return
+		}
+
+		broker = fileBroker
+	case "memory":
+		// notifications would never leave the process, only cmd/local connects the scheduler and the sender by it
+		fmt.Println("memory message broker is supported only by cmd/local")
+
+		return
+	default:
+		fmt.Println(fmt.Errorf("invalid message broker type: %s", config.MessageBroker.Type))
 
 		return
 	}
 
-	sender := senderapp.New(logger, consumerRbmq)
+	fileChannel, err := channel.NewFile(config.Channels.File.Path)
+	if err != nil {
+		fmt.Println("cant create file channel: ", err)
+
+		return
+	}
+
+	defer fileChannel.Close()
+
+	channels := []senderapp.Channel{fileChannel}
+	if config.Channels.SMTP.Addr != "" {
+		tlsConfig, err := smtpTLSConfig(config.Channels.SMTP.TLS)
+		if err != nil {
+			fmt.Println("cant create smtp tls config: ", err)
+
+			return
+		}
+
+		channels = append(channels, channel.NewSMTP(config.Channels.SMTP.Addr, config.Channels.SMTP.From,
+			config.Channels.SMTP.Username, config.Channels.SMTP.Password, tlsConfig))
+	}
+
+	if config.Channels.Webhook.URL != "" {
+		channels = append(channels, channel.NewWebhook(config.Channels.Webhook.URL, config.Channels.Webhook.Timeout))
+	}
+
+	users := make(map[string]senderapp.Recipient, len(config.Channels.Users))
+	for userID, u := range config.Channels.Users {
+		users[userID] = senderapp.Recipient{Channels: u.Channels, Locale: u.Locale, TimeZone: u.TimeZone}
+	}
+
+	router, err := senderapp.NewRouter(channels, config.Channels.Default, users)
+	if err != nil {
+		fmt.Println("cant create channel router: ", err)
+
+		return
+	}
+
+	if len(config.Channels.Only) > 0 {
+		if err := router.Restrict(config.Channels.Only); err != nil {
+			fmt.Println("cant restrict channels: ", err)
+
+			return
+		}
+	}
+
+	// copies of notifications published per channel are delivered only by senders of their queues
+	if config.MessageBroker.Type == "rabbitmq" {
+		routed, err := config.MessageBroker.RBMQ.topology().QueueChannels(config.MessageBroker.QueueName)
+		if err == nil {
+			err = router.Delivers(routed)
+		}
+
+		if err != nil {
+			fmt.Println("cant deliver channels routed to the queue: ", err)
+
+			return
+		}
+	}
+
+	templates, err := senderapp.LoadTemplates(config.Templates.Dir, config.Templates.DefaultLocale,
+		config.Templates.EventURL, router.Names())
+	if err != nil {
+		fmt.Println("cant load templates: ", err)
+
+		return
+	}
+
+	sender := senderapp.New(logger, broker, router, templates)
 
 	go func() {
 		defer cancel()
@@ -68,3 +174,27 @@ func main() {
 	ctx, cancel = context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 }
+
+func smtpTLSConfig(conf TLS) (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName:         conf.ServerName,
+		InsecureSkipVerify: conf.InsecureSkipVerify, //nolint:gosec
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if conf.CAFile == "" {
+		return config, nil
+	}
+
+	pem, err := os.ReadFile(conf.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("cant read ca file: %w", err)
+	}
+
+	config.RootCAs = x509.NewCertPool()
+	if !config.RootCAs.AppendCertsFromPEM(pem) {
+		return nil, errors.New("no certificates in ca file")
+	}
+
+	return config, nil
+}
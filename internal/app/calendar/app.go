@@ -25,42 +25,85 @@ type Logger interface {
 }
 
 type Storage interface {
-	ExistsEventByID(context.Context, string) (bool, error)
+	GetEventByID(context.Context, string) (*storage.Event, error)
+	GetEventOverrides(context.Context, string) ([]*storage.Event, error)
 	CreateEvent(context.Context, *storage.Event) error
 	UpdateEvent(context.Context, string, *storage.Event) error
-	DeleteEvent(context.Context, string) error
-	GetEventsByDaySorted(context.Context, time.Time, int64, int64) ([]*storage.Event, error)
+	DeleteEvent(context.Context, string, string) error
+	SplitSeries(context.Context, *storage.Event, time.Time, *storage.Event) error
+	GetEventsByDaySorted(context.Context, string, time.Time, int64, int64) ([]*storage.Event, error)
+	GetEventsByRangeSorted(context.Context, string, time.Time, time.Time, *storage.Cursor, int64) (
+		[]*storage.Event,
+		error)
+	GetAttendees(context.Context, string) ([]*storage.Attendee, error)
+	SaveAttendee(context.Context, *storage.Attendee) error
+	DeleteAttendee(context.Context, string, string) error
+	GetBusyEventsOfUsers(context.Context, []string, time.Time, time.Time) ([]*storage.Event, error)
 }
 
 var (
 	ErrUnexpected         = errors.New("unknown error")
+	ErrUnauthenticated    = errors.New("user is not authenticated")
 	ErrEventAlreadyExists = errors.New("event already exists")
 	ErrEventNotFound      = errors.New("event not found")
 	ErrInvalidDateFormat  = errors.New("invalid date format")
+	ErrInvalidTimeZone    = errors.New("invalid time zone")
+	ErrEventNotRecurring  = errors.New("event is not recurring")
+	ErrOccurrenceNotFound = errors.New("occurrence not found")
+	ErrInvalidRange       = errors.New("range start should be before its end")
+	ErrInvalidPageToken   = errors.New("invalid page token")
+	ErrAttendeeNotFound   = errors.New("attendee not found")
+	ErrInvalidAttendee    = errors.New("invalid attendee")
+	ErrEventConflict      = errors.New("event overlaps other events")
+	ErrInvalidUsers       = errors.New("invalid list of users")
+	ErrInvalidSlotQuery   = errors.New("invalid duration or working hours")
 )
 
 func New(logger Logger, storage Storage, uuidGen UUIDGenerator) *App {
 	return &App{logger: logger, storage: storage, uuIDGen: uuidGen}
 }
 
-func (a *App) checkEventExists(ctx context.Context, uuid string) error {
-	exists, err := a.storage.ExistsEventByID(ctx, uuid)
+func (a *App) userID(ctx context.Context) (string, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return "", ErrUnauthenticated
+	}
+
+	return userID, nil
+}
+
+func (a *App) getEvent(ctx context.Context, uuid string) (*storage.Event, error) {
+	userID, err := a.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := a.storage.GetEventByID(ctx, uuid)
 	if err != nil {
-		a.logger.WarningWithFields(fmt.Sprintf("cant check exists event with err: %v", err.Error()), map[string]interface{}{
+		if errors.Is(err, ErrEventNotFound) {
+			return nil, ErrEventNotFound
+		}
+
+		a.logger.WarningWithFields(fmt.Sprintf("cant get event with err: %v", err.Error()), map[string]interface{}{
 			"eventUUID": uuid,
 		})
 
-		return ErrUnexpected
+		return nil, ErrUnexpected
 	}
 
-	if !exists {
-		return ErrEventNotFound
+	if event.UserID != userID {
+		return nil, ErrEventNotFound
 	}
 
-	return nil
+	return event, nil
 }
 
 func (a *App) CreateEvent(ctx context.Context, event *storage.Event) (string, error) {
+	userID, err := a.userID(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	uuid, err := a.uuIDGen.Generate()
 	if err != nil {
 		a.logger.Warning(fmt.Sprintf("cant generate uuid: %v", err.Error()))
@@ -68,7 +111,17 @@ func (a *App) CreateEvent(ctx context.Context, event *storage.Event) (string, er
 		return "", ErrUnexpected
 	}
 
+	if err = a.checkConflicts(ctx, userID, event, event.RecurringEventID); err != nil {
+		return "", err
+	}
+
 	event.ID = uuid
+	event.UserID = userID
+	event.CreatedAt = time.Now()
+
+	if event.Reminders == nil {
+		event.Reminders = append([]time.Duration{}, storage.DefaultReminders...)
+	}
 
 	err = a.storage.CreateEvent(ctx, event)
 	if err != nil {
@@ -82,13 +135,64 @@ func (a *App) CreateEvent(ctx context.Context, event *storage.Event) (string, er
 	return uuid, nil
 }
 
+// GetEvent returns event of the calling user or event the user is invited to along with its attendees.
+func (a *App) GetEvent(ctx context.Context, uuid string) (*storage.Event, error) {
+	event, err := a.getEvent(ctx, uuid)
+	if errors.Is(err, ErrEventNotFound) {
+		if _, err = a.getInvitation(ctx, uuid); err != nil {
+			return nil, err
+		}
+
+		event, err = a.storage.GetEventByID(ctx, uuid)
+		if err != nil {
+			return nil, a.unexpected(err, "cant get event", uuid)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	event.Attendees, err = a.storage.GetAttendees(ctx, uuid)
+	if err != nil {
+		return nil, a.unexpected(err, "cant get attendees", uuid)
+	}
+
+	return event, nil
+}
+
 func (a *App) UpdateEvent(ctx context.Context, uuid string, event *storage.Event) error {
-	err := a.checkEventExists(ctx, uuid)
+	stored, err := a.getEvent(ctx, uuid)
 	if err != nil {
 		return err
 	}
 
+	// owner, exceptions of the series and link of an overridden occurrence survive full update
+	event.UserID = stored.UserID
+	event.ExDates = stored.ExDates
+	event.RecurringEventID = stored.RecurringEventID
+	event.RecurrenceID = stored.RecurrenceID
+	event.CreatedAt = stored.CreatedAt
+	event.UpdatedAt = time.Now()
+
+	if event.Reminders == nil {
+		event.Reminders = stored.Reminders
+	}
+
+	seriesID := uuid
+	if stored.RecurringEventID != "" {
+		seriesID = stored.RecurringEventID
+	}
+
+	if err = a.checkConflicts(ctx, stored.UserID, event, seriesID); err != nil {
+		return err
+	}
+
 	err = a.storage.UpdateEvent(ctx, uuid, event)
+	if errors.Is(err, ErrEventNotFound) {
+		return ErrEventNotFound
+	}
+
 	if err != nil {
 		a.logger.WarningWithFields(fmt.Sprintf("cant update event with err: %v", err.Error()), map[string]interface{}{
 			"eventUUID": uuid,
@@ -101,12 +205,16 @@ func (a *App) UpdateEvent(ctx context.Context, uuid string, event *storage.Event
 }
 
 func (a *App) DeleteEvent(ctx context.Context, uuid string) error {
-	err := a.checkEventExists(ctx, uuid)
+	userID, err := a.userID(ctx)
 	if err != nil {
 		return err
 	}
 
-	err = a.storage.DeleteEvent(ctx, uuid)
+	err = a.storage.DeleteEvent(ctx, userID, uuid)
+	if errors.Is(err, ErrEventNotFound) {
+		return ErrEventNotFound
+	}
+
 	if err != nil {
 		a.logger.WarningWithFields(fmt.Sprintf("cant delete event with err: %v", err.Error()), map[string]interface{}{
 			"eventUUID": uuid,
@@ -118,16 +226,30 @@ func (a *App) DeleteEvent(ctx context.Context, uuid string) error {
 	return nil
 }
 
-func (a *App) GetEventsByDay(ctx context.Context, day string, limit, offset int64) ([]*storage.Event, error) {
-	dayTime, err := time.Parse("2006-01-02", day)
+// GetEventsByDay returns events of the day in time zone, so the day may last 23 or 25 hours on DST transitions.
+func (a *App) GetEventsByDay(ctx context.Context, day, timeZone string, limit, offset int64) (
+	[]*storage.Event,
+	error) {
+	userID, err := a.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := storage.LoadLocation(timeZone)
+	if err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+
+	dayTime, err := time.ParseInLocation("2006-01-02", day, loc)
 	if err != nil {
 		return nil, ErrInvalidDateFormat
 	}
 
-	events, err := a.storage.GetEventsByDaySorted(ctx, dayTime, limit, offset)
+	events, err := a.storage.GetEventsByDaySorted(ctx, userID, dayTime, limit, offset)
 	if err != nil {
 		a.logger.WarningWithFields(fmt.Sprintf("cant get events by day with err: %v", err.Error()), map[string]interface{}{
-			"day": day,
+			"day":      day,
+			"timeZone": timeZone,
 		})
 
 		return nil, ErrUnexpected
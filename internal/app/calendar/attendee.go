@@ -0,0 +1,85 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+// InviteAttendee adds the attendee to the event of the calling user or updates invitation of the same user,
+// response to the invitation is reset.
+func (a *App) InviteAttendee(ctx context.Context, uuid string, attendee *storage.Attendee) error {
+	event, err := a.getEvent(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	if attendee.UserID == event.UserID {
+		return ErrInvalidAttendee
+	}
+
+	attendee.EventID = event.ID
+	attendee.Status = storage.StatusNeedsAction
+	if err = a.storage.SaveAttendee(ctx, attendee); err != nil {
+		return a.unexpected(err, "cant save attendee", uuid)
+	}
+
+	return nil
+}
+
+// RemoveAttendee removes the attendee from the event of the calling user.
+func (a *App) RemoveAttendee(ctx context.Context, uuid, userID string) error {
+	if _, err := a.getEvent(ctx, uuid); err != nil {
+		return err
+	}
+
+	if err := a.storage.DeleteAttendee(ctx, uuid, userID); err != nil {
+		if errors.Is(err, ErrAttendeeNotFound) {
+			return ErrAttendeeNotFound
+		}
+
+		return a.unexpected(err, "cant delete attendee", uuid)
+	}
+
+	return nil
+}
+
+// RespondToInvitation sets response of the calling user invited to the event.
+func (a *App) RespondToInvitation(ctx context.Context, uuid string, status storage.RSVPStatus) error {
+	attendee, err := a.getInvitation(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	if err = attendee.Respond(status); err != nil {
+		return ErrInvalidAttendee
+	}
+
+	if err = a.storage.SaveAttendee(ctx, attendee); err != nil {
+		return a.unexpected(err, "cant save attendee", uuid)
+	}
+
+	return nil
+}
+
+// getInvitation returns the calling user among attendees of the event.
+func (a *App) getInvitation(ctx context.Context, uuid string) (*storage.Attendee, error) {
+	userID, err := a.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attendees, err := a.storage.GetAttendees(ctx, uuid)
+	if err != nil {
+		return nil, a.unexpected(err, "cant get attendees", uuid)
+	}
+
+	for _, attendee := range attendees {
+		if attendee.UserID == userID {
+			return attendee, nil
+		}
+	}
+
+	return nil, ErrEventNotFound
+}
@@ -0,0 +1,74 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+// conflictHorizon limits the period occurrences of recurring events are checked for conflicts in.
+const conflictHorizon = 365 * 24 * time.Hour
+
+// ConflictError lists events of the owner overlapping the checked event.
+type ConflictError struct {
+	EventIDs []string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrEventConflict, strings.Join(e.EventIDs, ", "))
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrEventConflict
+}
+
+// checkConflicts returns ConflictError when the event overlaps other events of the user, instances and overrides
+// of the series seriesID are ignored. All-day events neither conflict nor are checked, events with AllowOverlap
+// are not checked.
+func (a *App) checkConflicts(ctx context.Context, userID string, event *storage.Event, seriesID string) error {
+	if event.AllowOverlap || event.AllDay {
+		return nil
+	}
+
+	occurrences, err := event.Occurrences(event.Start, event.Start.Add(conflictHorizon))
+	if err != nil {
+		return a.unexpected(err, "cant expand event", seriesID)
+	}
+
+	if len(occurrences) == 0 {
+		return nil
+	}
+
+	busy, err := a.storage.GetBusyEventsOfUsers(ctx, []string{userID}, occurrences[0].Start,
+		occurrences[len(occurrences)-1].Finish)
+	if err != nil {
+		return a.unexpected(err, "cant get busy events", seriesID)
+	}
+
+	conflicts := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, b := range busy {
+		if seen[b.ID] || b.AllDay || (seriesID != "" && (b.ID == seriesID || b.RecurringEventID == seriesID)) {
+			continue
+		}
+
+		for _, o := range occurrences {
+			if o.Start.Before(b.Finish) && o.Finish.After(b.Start) {
+				seen[b.ID] = true
+				conflicts = append(conflicts, b.ID)
+
+				break
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return &ConflictError{EventIDs: conflicts}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package calendar_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/app/calendar"
+	"github.com/seregproj/calendar/internal/auth"
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckConflicts(t *testing.T) {
+	ctx := userCtx()
+	otherCtx := auth.ContextWithPrincipal(context.Background(), &auth.Principal{UserID: "user2"})
+	// monday
+	begin := time.Date(2030, 5, 6, 10, 0, 0, 0, time.UTC)
+	at := func(days, minutes int) time.Time {
+		return begin.AddDate(0, 0, days).Add(time.Duration(minutes) * time.Minute)
+	}
+	event := func(start, finish time.Time) *storage.Event {
+		return &storage.Event{Title: "Meeting", Start: start, Finish: finish}
+	}
+
+	t.Run("overlapping event of the user conflicts", func(t *testing.T) {
+		app := calendar.New(nopLogger{}, memorystorage.New(), storage.NewUUIDGen())
+		id, err := app.CreateEvent(ctx, event(at(0, 0), at(0, 60)))
+		require.NoError(t, err)
+
+		_, err = app.CreateEvent(ctx, event(at(0, 30), at(0, 90)))
+		require.ErrorIs(t, err, calendar.ErrEventConflict)
+
+		var conflictErr *calendar.ConflictError
+		require.ErrorAs(t, err, &conflictErr)
+		require.Equal(t, []string{id}, conflictErr.EventIDs)
+
+		_, err = app.CreateEvent(ctx, event(at(0, 10), at(0, 20)))
+		require.ErrorIs(t, err, calendar.ErrEventConflict, "the event inside the other one conflicts")
+
+		_, err = app.CreateEvent(otherCtx, event(at(0, 30), at(0, 90)))
+		require.NoError(t, err, "events of other users do not conflict")
+
+		moved := event(at(0, 15), at(0, 75))
+		require.NoError(t, app.UpdateEvent(ctx, id, moved), "the event does not conflict with itself")
+	})
+
+	t.Run("adjacent events do not conflict", func(t *testing.T) {
+		app := calendar.New(nopLogger{}, memorystorage.New(), storage.NewUUIDGen())
+		_, err := app.CreateEvent(ctx, event(at(0, 0), at(0, 60)))
+		require.NoError(t, err)
+
+		_, err = app.CreateEvent(ctx, event(at(0, 60), at(0, 120)))
+		require.NoError(t, err)
+
+		_, err = app.CreateEvent(ctx, event(at(0, -60), at(0, 0)))
+		require.NoError(t, err)
+	})
+
+	t.Run("all-day events do not conflict", func(t *testing.T) {
+		app := calendar.New(nopLogger{}, memorystorage.New(), storage.NewUUIDGen())
+		_, err := app.CreateEvent(ctx, event(at(0, 0), at(0, 60)))
+		require.NoError(t, err)
+
+		day := time.Date(2030, 5, 6, 0, 0, 0, 0, time.UTC)
+		allDay := event(day, day.AddDate(0, 0, 1))
+		allDay.AllDay = true
+		_, err = app.CreateEvent(ctx, allDay)
+		require.NoError(t, err)
+
+		_, err = app.CreateEvent(ctx, event(at(0, 120), at(0, 180)))
+		require.NoError(t, err, "the all-day event is not busy time")
+	})
+
+	t.Run("occurrences of recurring series conflict", func(t *testing.T) {
+		app := calendar.New(nopLogger{}, memorystorage.New(), storage.NewUUIDGen())
+		series := event(at(0, 0), at(0, 30))
+		series.RRule = "FREQ=DAILY;COUNT=5"
+		seriesID, err := app.CreateEvent(ctx, series)
+		require.NoError(t, err)
+
+		_, err = app.CreateEvent(ctx, event(at(3, 15), at(3, 45)))
+		require.ErrorIs(t, err, calendar.ErrEventConflict, "the occurrence of the fourth day")
+
+		_, err = app.CreateEvent(ctx, event(at(5, 15), at(5, 45)))
+		require.NoError(t, err, "the series ends before")
+
+		weekly := event(at(-7, 120), at(-7, 180))
+		weekly.RRule = "FREQ=WEEKLY"
+		_, err = app.CreateEvent(ctx, weekly)
+		require.NoError(t, err)
+
+		later := event(at(14, 130), at(14, 140))
+		_, err = app.CreateEvent(ctx, later)
+		require.ErrorIs(t, err, calendar.ErrEventConflict, "the occurrence of the weekly series weeks later")
+
+		override := event(at(2, 10), at(2, 40))
+		require.NoError(t, app.UpdateOccurrence(ctx, seriesID, at(2, 0), override, calendar.ScopeThis),
+			"the occurrence does not conflict with its series")
+	})
+}
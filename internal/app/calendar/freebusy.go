@@ -0,0 +1,81 @@
+package calendar
+
+import (
+	"context"
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+// limits of free/busy queries.
+const (
+	MaxFreeBusyUsers  = 50
+	MaxFreeBusyWindow = 92 * 24 * time.Hour
+)
+
+// Interval is a period of time [Start, Finish).
+type Interval struct {
+	Start  time.Time
+	Finish time.Time
+}
+
+// FreeBusy returns merged busy intervals of every user in [from, to) without details of the events,
+// all-day events leave the time free.
+func (a *App) FreeBusy(ctx context.Context, userIDs []string, from, to time.Time) (map[string][]Interval, error) {
+	if _, err := a.userID(ctx); err != nil {
+		return nil, err
+	}
+
+	if !from.Before(to) || to.Sub(from) > MaxFreeBusyWindow {
+		return nil, ErrInvalidRange
+	}
+
+	if len(userIDs) == 0 || len(userIDs) > MaxFreeBusyUsers {
+		return nil, ErrInvalidUsers
+	}
+
+	events, err := a.storage.GetBusyEventsOfUsers(ctx, userIDs, from, to)
+	if err != nil {
+		return nil, a.unexpected(err, "cant get busy events", "")
+	}
+
+	byUser := make(map[string][]*storage.Event, len(userIDs))
+	for _, e := range events {
+		byUser[e.UserID] = append(byUser[e.UserID], e)
+	}
+
+	busy := make(map[string][]Interval, len(userIDs))
+	for _, id := range userIDs {
+		busy[id] = mergeIntervals(byUser[id], from, to)
+	}
+
+	return busy, nil
+}
+
+// mergeIntervals merges overlapping and adjacent events sorted by start into intervals clipped to [from, to).
+func mergeIntervals(events []*storage.Event, from, to time.Time) []Interval {
+	intervals := make([]Interval, 0, len(events))
+
+	for _, e := range events {
+		start, finish := e.Start, e.Finish
+		if start.Before(from) {
+			start = from
+		}
+
+		if finish.After(to) {
+			finish = to
+		}
+
+		if n := len(intervals); n > 0 && !start.After(intervals[n-1].Finish) {
+			if finish.After(intervals[n-1].Finish) {
+				intervals[n-1].Finish = finish.UTC()
+			}
+
+			continue
+		}
+
+		intervals = append(intervals, Interval{Start: start.UTC(), Finish: finish.UTC()})
+	}
+
+	return intervals
+}
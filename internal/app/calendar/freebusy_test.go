@@ -0,0 +1,36 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeIntervals(t *testing.T) {
+	begin := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return begin.Add(time.Duration(minutes) * time.Minute)
+	}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	events := []*storage.Event{
+		{Start: at(-30), Finish: at(30)},
+		{Start: at(20), Finish: at(40)},
+		{Start: at(40), Finish: at(60)},
+		{Start: at(70).In(berlin), Finish: at(80).In(berlin)},
+		{Start: at(75), Finish: at(78)},
+		{Start: at(110), Finish: at(200)},
+	}
+
+	require.Equal(t, []Interval{
+		{Start: at(0), Finish: at(60)},
+		{Start: at(70), Finish: at(80)},
+		{Start: at(110), Finish: at(120)},
+	}, mergeIntervals(events, at(0), at(120)))
+
+	require.Equal(t, []Interval{}, mergeIntervals(nil, at(0), at(120)))
+}
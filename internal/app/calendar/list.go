@@ -0,0 +1,100 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+type Period int
+
+// periods of convenience listings.
+const (
+	PeriodWeek Period = iota
+	PeriodMonth
+)
+
+// page sizes of listings.
+const (
+	DefaultPageSize int64 = 50
+	MaxPageSize     int64 = 500
+)
+
+// ListEvents returns page of events overlapping [from, to) sorted by start and id
+// and token of the next page, which is empty for the last page.
+func (a *App) ListEvents(ctx context.Context, from, to time.Time, pageSize int64, pageToken string) (
+	[]*storage.Event,
+	string,
+	error) {
+	userID, err := a.userID(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !from.Before(to) {
+		return nil, "", ErrInvalidRange
+	}
+
+	after, err := storage.DecodeCursor(pageToken)
+	if err != nil {
+		return nil, "", ErrInvalidPageToken
+	}
+
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	// one more event tells whether the next page exists
+	events, err := a.storage.GetEventsByRangeSorted(ctx, userID, from, to, after, pageSize+1)
+	if err != nil {
+		a.logger.WarningWithFields(fmt.Sprintf("cant get events by range with err: %v", err.Error()),
+			map[string]interface{}{
+				"from": from,
+				"to":   to,
+			})
+
+		return nil, "", ErrUnexpected
+	}
+
+	if int64(len(events)) <= pageSize {
+		return events, "", nil
+	}
+
+	events = events[:pageSize]
+
+	return events, storage.CursorOf(events[pageSize-1]).Encode(), nil
+}
+
+// ListEventsByPeriod lists events of the week starting on monday or of the month containing the day
+// in time zone.
+func (a *App) ListEventsByPeriod(ctx context.Context, day, timeZone string, period Period, pageSize int64,
+	pageToken string) ([]*storage.Event, string, error) {
+	loc, err := storage.LoadLocation(timeZone)
+	if err != nil {
+		return nil, "", ErrInvalidTimeZone
+	}
+
+	dayTime, err := time.ParseInLocation("2006-01-02", day, loc)
+	if err != nil {
+		return nil, "", ErrInvalidDateFormat
+	}
+
+	var from, to time.Time
+
+	switch period {
+	case PeriodWeek:
+		from = dayTime.AddDate(0, 0, -int(dayTime.Weekday()+6)%7)
+		to = from.AddDate(0, 0, 7)
+	case PeriodMonth:
+		from = time.Date(dayTime.Year(), dayTime.Month(), 1, 0, 0, 0, 0, loc)
+		to = from.AddDate(0, 1, 0)
+	}
+
+	return a.ListEvents(ctx, from, to, pageSize, pageToken)
+}
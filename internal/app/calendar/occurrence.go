@@ -0,0 +1,265 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+type OccurrenceScope int
+
+// scopes of occurrence changes.
+const (
+	ScopeThis OccurrenceScope = iota
+	ScopeThisAndFollowing
+	ScopeAll
+)
+
+func (a *App) getSeries(ctx context.Context, uuid string, recurrenceID time.Time) (*storage.Event, error) {
+	series, err := a.getEvent(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !series.IsRecurring() {
+		return nil, ErrEventNotRecurring
+	}
+
+	ok, err := series.HasOccurrence(recurrenceID)
+	if err != nil {
+		a.logger.WarningWithFields(fmt.Sprintf("cant check occurrence with err: %v", err.Error()), map[string]interface{}{
+			"eventUUID":    uuid,
+			"recurrenceID": recurrenceID,
+		})
+
+		return nil, ErrUnexpected
+	}
+
+	if !ok {
+		return nil, ErrOccurrenceNotFound
+	}
+
+	return series, nil
+}
+
+func (a *App) getOverride(ctx context.Context, series *storage.Event, recurrenceID time.Time) (
+	*storage.Event,
+	error) {
+	overrides, err := a.storage.GetEventOverrides(ctx, series.ID)
+	if err != nil {
+		a.logger.WarningWithFields(fmt.Sprintf("cant get overrides with err: %v", err.Error()), map[string]interface{}{
+			"eventUUID": series.ID,
+		})
+
+		return nil, ErrUnexpected
+	}
+
+	for _, o := range overrides {
+		if o.RecurrenceID.Equal(recurrenceID) {
+			return o, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// UpdateOccurrence changes single occurrence of the series, the occurrence and all following ones
+// or the whole series depending on scope.
+func (a *App) UpdateOccurrence(ctx context.Context, uuid string, recurrenceID time.Time, event *storage.Event,
+	scope OccurrenceScope) error {
+	series, err := a.getSeries(ctx, uuid, recurrenceID)
+	if err != nil {
+		return err
+	}
+
+	scope, rest, err := a.truncateSeries(series, recurrenceID, scope)
+	if err != nil {
+		return err
+	}
+
+	switch scope {
+	case ScopeAll:
+		if !event.IsRecurring() {
+			event.RRule = series.RRule
+		}
+
+		return a.UpdateEvent(ctx, uuid, event)
+	case ScopeThisAndFollowing:
+		if !event.IsRecurring() {
+			event.RRule = rest.String()
+		}
+
+		if event.Reminders == nil {
+			event.Reminders = series.Reminders
+		}
+
+		if err = a.checkConflicts(ctx, series.UserID, event, uuid); err != nil {
+			return err
+		}
+
+		restID, err := a.uuIDGen.Generate()
+		if err != nil {
+			return a.unexpected(err, "cant generate uuid", uuid)
+		}
+
+		event.ID = restID
+		event.UserID = series.UserID
+		event.RecurringEventID = ""
+		event.RecurrenceID = time.Time{}
+		event.CreatedAt = time.Now()
+		series.UpdatedAt = event.CreatedAt
+
+		if err = a.storage.SplitSeries(ctx, series, recurrenceID, event); err != nil {
+			return a.unexpected(err, "cant split series", uuid)
+		}
+
+		return nil
+	}
+
+	override, err := a.getOverride(ctx, series, recurrenceID)
+	if err != nil {
+		return err
+	}
+
+	if override == nil && series.IsExDate(recurrenceID) {
+		return ErrOccurrenceNotFound
+	}
+
+	event.RRule = ""
+	event.ExDates = nil
+	event.UserID = series.UserID
+	event.RecurringEventID = series.ID
+	event.RecurrenceID = recurrenceID
+
+	if override != nil {
+		if err = a.checkConflicts(ctx, series.UserID, event, series.ID); err != nil {
+			return err
+		}
+
+		if event.Reminders == nil {
+			stored, err := a.storage.GetEventByID(ctx, override.ID)
+			if err != nil {
+				return a.unexpected(err, "cant get overridden occurrence", uuid)
+			}
+
+			event.Reminders = stored.Reminders
+		}
+
+		event.ID = override.ID
+		event.CreatedAt = override.CreatedAt
+		event.UpdatedAt = time.Now()
+		if err = a.storage.UpdateEvent(ctx, override.ID, event); err != nil {
+			return a.unexpected(err, "cant update overridden occurrence", uuid)
+		}
+
+		return nil
+	}
+
+	if event.Reminders == nil {
+		event.Reminders = series.Reminders
+	}
+
+	overrideID, err := a.CreateEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	series.AddExDate(recurrenceID)
+	if err = a.updateSeries(ctx, series); err != nil {
+		// the occurrence would be duplicated by the override while the series has no exception for it
+		if delErr := a.storage.DeleteEvent(ctx, series.UserID, overrideID); delErr != nil {
+			return a.unexpected(delErr, "cant roll back overridden occurrence", uuid)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// DeleteOccurrence cancels single occurrence of the series, the occurrence and all following ones
+// or the whole series depending on scope.
+func (a *App) DeleteOccurrence(ctx context.Context, uuid string, recurrenceID time.Time,
+	scope OccurrenceScope) error {
+	series, err := a.getSeries(ctx, uuid, recurrenceID)
+	if err != nil {
+		return err
+	}
+
+	scope, _, err = a.truncateSeries(series, recurrenceID, scope)
+	if err != nil {
+		return err
+	}
+
+	switch scope {
+	case ScopeAll:
+		return a.DeleteEvent(ctx, uuid)
+	case ScopeThisAndFollowing:
+		series.UpdatedAt = time.Now()
+		if err = a.storage.SplitSeries(ctx, series, recurrenceID, nil); err != nil {
+			return a.unexpected(err, "cant split series", uuid)
+		}
+
+		return nil
+	}
+
+	override, err := a.getOverride(ctx, series, recurrenceID)
+	if err != nil {
+		return err
+	}
+
+	if override != nil {
+		if err = a.storage.DeleteEvent(ctx, series.UserID, override.ID); err != nil {
+			return a.unexpected(err, "cant delete overridden occurrence", uuid)
+		}
+	}
+
+	series.AddExDate(recurrenceID)
+	if err = a.updateSeries(ctx, series); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// truncateSeries ends the series before the occurrence for changes of this and following occurrences
+// and returns the rule for the rest of the series. Changes of the first occurrence and following ones are
+// changes of all occurrences.
+func (a *App) truncateSeries(series *storage.Event, recurrenceID time.Time, scope OccurrenceScope) (
+	OccurrenceScope,
+	*storage.RecurrenceRule,
+	error) {
+	if scope != ScopeThisAndFollowing {
+		return scope, nil, nil
+	}
+
+	rest, err := series.TruncateSeries(recurrenceID)
+	switch {
+	case errors.Is(err, storage.ErrNoOccurrencesBefore):
+		return ScopeAll, nil, nil
+	case err != nil:
+		return scope, nil, a.unexpected(err, "cant truncate series", series.ID)
+	}
+
+	return scope, rest, nil
+}
+
+func (a *App) updateSeries(ctx context.Context, series *storage.Event) error {
+	series.UpdatedAt = time.Now()
+	if err := a.storage.UpdateEvent(ctx, series.ID, series); err != nil {
+		return a.unexpected(err, "cant update series", series.ID)
+	}
+
+	return nil
+}
+
+func (a *App) unexpected(err error, text, uuid string) error {
+	a.logger.WarningWithFields(fmt.Sprintf("%s with err: %v", text, err.Error()), map[string]interface{}{
+		"eventUUID": uuid,
+	})
+
+	return ErrUnexpected
+}
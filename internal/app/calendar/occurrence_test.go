@@ -0,0 +1,278 @@
+package calendar_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/app/calendar"
+	"github.com/seregproj/calendar/internal/auth"
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+// failingStorage fails updates of events while failUpdate is set.
+type failingStorage struct {
+	*memorystorage.Storage
+	failUpdate bool
+}
+
+func (s *failingStorage) UpdateEvent(ctx context.Context, uuid string, event *storage.Event) error {
+	if s.failUpdate {
+		return errors.New("connection is lost")
+	}
+
+	return s.Storage.UpdateEvent(ctx, uuid, event)
+}
+
+func (s *failingStorage) SplitSeries(ctx context.Context, series *storage.Event, recurrenceID time.Time,
+	rest *storage.Event) error {
+	if s.failUpdate {
+		return errors.New("connection is lost")
+	}
+
+	return s.Storage.SplitSeries(ctx, series, recurrenceID, rest)
+}
+
+// userCtx is context of the owner of series.
+func userCtx() context.Context {
+	return auth.ContextWithPrincipal(context.Background(), &auth.Principal{UserID: "user1"})
+}
+
+// newSeries creates daily series of the owner starting at the time and returns its id.
+func newSeries(t *testing.T, app *calendar.App, start time.Time) string {
+	t.Helper()
+
+	id, err := app.CreateEvent(userCtx(), &storage.Event{
+		Title:  "Standup",
+		Start:  start,
+		Finish: start.Add(15 * time.Minute),
+		RRule:  "FREQ=DAILY",
+	})
+	require.NoError(t, err)
+
+	return id
+}
+
+func TestUpdateOccurrence(t *testing.T) {
+	ctx := userCtx()
+	start := time.Date(2030, 5, 3, 10, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return start.AddDate(0, 0, n) }
+	changed := func(at time.Time) *storage.Event {
+		return &storage.Event{Title: "Retro", Start: at.Add(time.Hour), Finish: at.Add(90 * time.Minute)}
+	}
+
+	t.Run("this occurrence is overridden", func(t *testing.T) {
+		s := memorystorage.New()
+		app := calendar.New(nopLogger{}, s, storage.NewUUIDGen())
+		id := newSeries(t, app, start)
+
+		require.NoError(t, app.UpdateOccurrence(ctx, id, day(1), changed(day(1)), calendar.ScopeThis))
+		require.NoError(t, app.UpdateOccurrence(ctx, id, day(1), changed(day(1)), calendar.ScopeThis))
+
+		overrides, err := s.GetEventOverrides(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, overrides, 1, "the override is updated in place")
+		require.Equal(t, "Retro", overrides[0].Title)
+		require.True(t, overrides[0].RecurrenceID.Equal(day(1)))
+
+		series, err := app.GetEvent(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, "Standup", series.Title)
+		require.True(t, series.IsExDate(day(1)))
+	})
+
+	t.Run("override is rolled back when the series is not updated", func(t *testing.T) {
+		s := &failingStorage{Storage: memorystorage.New()}
+		app := calendar.New(nopLogger{}, s, storage.NewUUIDGen())
+		id := newSeries(t, app, start)
+
+		s.failUpdate = true
+		require.ErrorIs(t, app.UpdateOccurrence(ctx, id, day(1), changed(day(1)), calendar.ScopeThis),
+			calendar.ErrUnexpected)
+
+		overrides, err := s.GetEventOverrides(ctx, id)
+		require.NoError(t, err)
+		require.Empty(t, overrides)
+	})
+
+	t.Run("this and following occurrences split the series", func(t *testing.T) {
+		s := memorystorage.New()
+		app := calendar.New(nopLogger{}, s, storage.NewUUIDGen())
+		id := newSeries(t, app, start)
+		require.NoError(t, app.UpdateOccurrence(ctx, id, day(3), changed(day(3)), calendar.ScopeThis))
+
+		require.NoError(t, app.UpdateOccurrence(ctx, id, day(2), changed(day(2)), calendar.ScopeThisAndFollowing))
+
+		series, err := app.GetEvent(ctx, id)
+		require.NoError(t, err)
+		occurrences, err := series.Occurrences(start, day(10))
+		require.NoError(t, err)
+		require.Len(t, occurrences, 2, "the series ends before the occurrence")
+
+		overrides, err := s.GetEventOverrides(ctx, id)
+		require.NoError(t, err)
+		require.Empty(t, overrides, "overrides of following occurrences are deleted")
+
+		events, _, err := app.ListEvents(ctx, day(5), day(6), 0, "")
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "Retro", events[0].Title)
+		require.True(t, events[0].Start.Equal(day(5).Add(time.Hour)))
+	})
+
+	t.Run("first occurrence and following ones update the series", func(t *testing.T) {
+		app := calendar.New(nopLogger{}, memorystorage.New(), storage.NewUUIDGen())
+		// monday, the first occurrence is on tuesday
+		monday := time.Date(2030, 5, 6, 10, 0, 0, 0, time.UTC)
+		id, err := app.CreateEvent(ctx, &storage.Event{
+			Title: "Standup", Start: monday, Finish: monday.Add(15 * time.Minute), RRule: "FREQ=WEEKLY;BYDAY=TU;COUNT=3",
+		})
+		require.NoError(t, err)
+
+		tuesday := monday.AddDate(0, 0, 1)
+		require.NoError(t, app.UpdateOccurrence(ctx, id, tuesday, changed(monday), calendar.ScopeThisAndFollowing))
+
+		series, err := app.GetEvent(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, "Retro", series.Title)
+		occurrences, err := series.Occurrences(monday, monday.AddDate(1, 0, 0))
+		require.NoError(t, err)
+		require.Len(t, occurrences, 3, "the series is not made endless")
+	})
+
+	t.Run("rest of the series keeps attendees", func(t *testing.T) {
+		app := calendar.New(nopLogger{}, memorystorage.New(), storage.NewUUIDGen())
+		id := newSeries(t, app, start)
+		attendee, err := storage.NewAttendee("user2", "user2@example.com", storage.RoleRequired)
+		require.NoError(t, err)
+		require.NoError(t, app.InviteAttendee(ctx, id, attendee))
+
+		require.NoError(t, app.UpdateOccurrence(ctx, id, day(2), changed(day(2)), calendar.ScopeThisAndFollowing))
+
+		events, _, err := app.ListEvents(ctx, day(5), day(6), 0, "")
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		rest, err := app.GetEvent(ctx, events[0].ID)
+		require.NoError(t, err)
+		require.Len(t, rest.Attendees, 1)
+		require.Equal(t, "user2", rest.Attendees[0].UserID)
+		require.Equal(t, rest.ID, rest.Attendees[0].EventID)
+	})
+
+	t.Run("series is not split when the rest is not stored", func(t *testing.T) {
+		s := &failingStorage{Storage: memorystorage.New()}
+		app := calendar.New(nopLogger{}, s, storage.NewUUIDGen())
+		id := newSeries(t, app, start)
+		require.NoError(t, app.UpdateOccurrence(ctx, id, day(3), changed(day(3)), calendar.ScopeThis))
+
+		s.failUpdate = true
+		require.ErrorIs(t, app.UpdateOccurrence(ctx, id, day(2), changed(day(2)), calendar.ScopeThisAndFollowing),
+			calendar.ErrUnexpected)
+
+		series, err := app.GetEvent(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, "FREQ=DAILY", series.RRule)
+
+		overrides, err := s.GetEventOverrides(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+	})
+
+	t.Run("all occurrences update the series", func(t *testing.T) {
+		s := memorystorage.New()
+		app := calendar.New(nopLogger{}, s, storage.NewUUIDGen())
+		id := newSeries(t, app, start)
+
+		require.NoError(t, app.UpdateOccurrence(ctx, id, day(2), changed(start), calendar.ScopeAll))
+
+		series, err := app.GetEvent(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, "Retro", series.Title)
+		require.Equal(t, "FREQ=DAILY", series.RRule)
+	})
+
+	t.Run("single event has no occurrences", func(t *testing.T) {
+		app := calendar.New(nopLogger{}, memorystorage.New(), storage.NewUUIDGen())
+		id, err := app.CreateEvent(ctx, changed(start))
+		require.NoError(t, err)
+
+		require.ErrorIs(t, app.UpdateOccurrence(ctx, id, start, changed(start), calendar.ScopeThis),
+			calendar.ErrEventNotRecurring)
+	})
+}
+
+func TestDeleteOccurrence(t *testing.T) {
+	ctx := userCtx()
+	start := time.Date(2030, 5, 3, 10, 0, 0, 0, time.UTC)
+	day := func(n int) time.Time { return start.AddDate(0, 0, n) }
+	moved := &storage.Event{Title: "Retro", Start: day(1).Add(time.Hour), Finish: day(1).Add(2 * time.Hour)}
+
+	t.Run("this occurrence is cancelled along with its override", func(t *testing.T) {
+		s := memorystorage.New()
+		app := calendar.New(nopLogger{}, s, storage.NewUUIDGen())
+		id := newSeries(t, app, start)
+		require.NoError(t, app.UpdateOccurrence(ctx, id, day(1), moved, calendar.ScopeThis))
+
+		require.NoError(t, app.DeleteOccurrence(ctx, id, day(1), calendar.ScopeThis))
+
+		overrides, err := s.GetEventOverrides(ctx, id)
+		require.NoError(t, err)
+		require.Empty(t, overrides)
+
+		series, err := app.GetEvent(ctx, id)
+		require.NoError(t, err)
+		require.True(t, series.IsExDate(day(1)))
+
+		require.ErrorIs(t, app.UpdateOccurrence(ctx, id, day(1), moved, calendar.ScopeThis),
+			calendar.ErrOccurrenceNotFound)
+	})
+
+	t.Run("this and following occurrences are cancelled", func(t *testing.T) {
+		app := calendar.New(nopLogger{}, memorystorage.New(), storage.NewUUIDGen())
+		id := newSeries(t, app, start)
+
+		require.NoError(t, app.DeleteOccurrence(ctx, id, day(3), calendar.ScopeThisAndFollowing))
+
+		series, err := app.GetEvent(ctx, id)
+		require.NoError(t, err)
+		occurrences, err := series.Occurrences(start, day(10))
+		require.NoError(t, err)
+		require.Len(t, occurrences, 3)
+	})
+
+	t.Run("first occurrence and following ones delete the series", func(t *testing.T) {
+		app := calendar.New(nopLogger{}, memorystorage.New(), storage.NewUUIDGen())
+		monday := time.Date(2030, 5, 6, 10, 0, 0, 0, time.UTC)
+		id, err := app.CreateEvent(ctx, &storage.Event{
+			Title: "Standup", Start: monday, Finish: monday.Add(15 * time.Minute), RRule: "FREQ=WEEKLY;BYDAY=TU;COUNT=3",
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, app.DeleteOccurrence(ctx, id, monday.AddDate(0, 0, 1), calendar.ScopeThisAndFollowing))
+
+		_, err = app.GetEvent(ctx, id)
+		require.ErrorIs(t, err, calendar.ErrEventNotFound)
+	})
+
+	t.Run("all occurrences delete the series", func(t *testing.T) {
+		app := calendar.New(nopLogger{}, memorystorage.New(), storage.NewUUIDGen())
+		id := newSeries(t, app, start)
+
+		require.NoError(t, app.DeleteOccurrence(ctx, id, day(3), calendar.ScopeAll))
+
+		_, err := app.GetEvent(ctx, id)
+		require.ErrorIs(t, err, calendar.ErrEventNotFound)
+	})
+
+	t.Run("unknown occurrence is not found", func(t *testing.T) {
+		app := calendar.New(nopLogger{}, memorystorage.New(), storage.NewUUIDGen())
+		id := newSeries(t, app, start)
+
+		require.ErrorIs(t, app.DeleteOccurrence(ctx, id, day(1).Add(time.Minute), calendar.ScopeThis),
+			calendar.ErrOccurrenceNotFound)
+	})
+}
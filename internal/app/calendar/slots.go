@@ -0,0 +1,213 @@
+package calendar
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+// defaults and limits of slot search.
+const (
+	DefaultSlotStep      = 15 * time.Minute
+	DefaultMaxSlots      = 10
+	MaxSlots             = 50
+	DefaultWorkdayStart  = 9 * time.Hour
+	DefaultWorkdayFinish = 18 * time.Hour
+	maxWorkdayOffset     = 24 * time.Hour
+)
+
+// SlotQuery describes a meeting to find time for.
+type SlotQuery struct {
+	UserIDs  []string
+	Duration time.Duration
+	From     time.Time
+	To       time.Time
+	// WorkdayStart and WorkdayFinish are offsets from midnight in TimeZone.
+	WorkdayStart  time.Duration
+	WorkdayFinish time.Duration
+	TimeZone      string
+	// Weekdays are working days, monday to friday when empty.
+	Weekdays   []time.Weekday
+	MaxResults int
+}
+
+type slot struct {
+	Interval
+	day int
+	// loose is number of slot edges not adjacent to busy time or workday bounds, such slots fragment free time
+	loose int
+}
+
+// FindAvailableSlots returns slots free for every user in working hours of the window. Slots are ranked by day,
+// then slots filling gaps between busy periods go first, then by start.
+func (a *App) FindAvailableSlots(ctx context.Context, query SlotQuery) ([]Interval, error) {
+	if _, err := a.userID(ctx); err != nil {
+		return nil, err
+	}
+
+	loc, err := storage.LoadLocation(query.TimeZone)
+	if err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+
+	if err = query.normalize(); err != nil {
+		return nil, err
+	}
+
+	events, err := a.storage.GetBusyEventsOfUsers(ctx, query.UserIDs, query.From, query.To)
+	if err != nil {
+		return nil, a.unexpected(err, "cant get busy events", "")
+	}
+
+	busy := mergeIntervals(events, query.From, query.To)
+	slots := make([]slot, 0)
+
+	y, m, d := query.From.In(loc).Date()
+	for day := 0; ; day++ {
+		date := time.Date(y, m, d+day, 0, 0, 0, 0, loc)
+		if !date.Before(query.To) {
+			break
+		}
+
+		if !query.isWorkday(date.Weekday()) {
+			continue
+		}
+
+		workday := Interval{Start: clock(date, query.WorkdayStart), Finish: clock(date, query.WorkdayFinish)}
+		for _, gap := range freeGaps(workday, busy, query.From, query.To) {
+			slots = append(slots, gapSlots(gap, date, day, query.Duration)...)
+		}
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		if slots[i].day != slots[j].day {
+			return slots[i].day < slots[j].day
+		}
+
+		if slots[i].loose != slots[j].loose {
+			return slots[i].loose < slots[j].loose
+		}
+
+		return slots[i].Start.Before(slots[j].Start)
+	})
+
+	result := make([]Interval, 0, query.MaxResults)
+	for i := 0; i < len(slots) && i < query.MaxResults; i++ {
+		result = append(result, Interval{Start: slots[i].Start.UTC(), Finish: slots[i].Finish.UTC()})
+	}
+
+	return result, nil
+}
+
+func (q *SlotQuery) normalize() error {
+	if len(q.UserIDs) == 0 || len(q.UserIDs) > MaxFreeBusyUsers {
+		return ErrInvalidUsers
+	}
+
+	if !q.From.Before(q.To) || q.To.Sub(q.From) > MaxFreeBusyWindow {
+		return ErrInvalidRange
+	}
+
+	if q.WorkdayStart == 0 && q.WorkdayFinish == 0 {
+		q.WorkdayStart, q.WorkdayFinish = DefaultWorkdayStart, DefaultWorkdayFinish
+	}
+
+	if q.WorkdayStart < 0 || q.WorkdayStart >= q.WorkdayFinish || q.WorkdayFinish > maxWorkdayOffset ||
+		q.Duration <= 0 || q.Duration > q.WorkdayFinish-q.WorkdayStart {
+		return ErrInvalidSlotQuery
+	}
+
+	if len(q.Weekdays) == 0 {
+		q.Weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	}
+
+	if q.MaxResults <= 0 {
+		q.MaxResults = DefaultMaxSlots
+	}
+
+	if q.MaxResults > MaxSlots {
+		q.MaxResults = MaxSlots
+	}
+
+	return nil
+}
+
+func (q *SlotQuery) isWorkday(wd time.Weekday) bool {
+	for _, v := range q.Weekdays {
+		if v == wd {
+			return true
+		}
+	}
+
+	return false
+}
+
+// clock returns wall clock time of the date, so the workday keeps its hours on DST transitions.
+func clock(date time.Time, offset time.Duration) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), int(offset/time.Hour), int(offset%time.Hour/time.Minute),
+		0, 0, date.Location())
+}
+
+// freeGaps returns parts of the workday inside [from, to) not covered by sorted merged busy intervals.
+func freeGaps(workday Interval, busy []Interval, from, to time.Time) []Interval {
+	if workday.Start.Before(from) {
+		workday.Start = from
+	}
+
+	if workday.Finish.After(to) {
+		workday.Finish = to
+	}
+
+	gaps := make([]Interval, 0)
+	start := workday.Start
+
+	for _, b := range busy {
+		if !b.Finish.After(start) {
+			continue
+		}
+
+		if !b.Start.Before(workday.Finish) {
+			break
+		}
+
+		if b.Start.After(start) {
+			gaps = append(gaps, Interval{Start: start, Finish: b.Start})
+		}
+
+		start = b.Finish
+	}
+
+	if start.Before(workday.Finish) {
+		gaps = append(gaps, Interval{Start: start, Finish: workday.Finish})
+	}
+
+	return gaps
+}
+
+// gapSlots returns slots of the gap starting on DefaultSlotStep grid from the midnight of the date, so the grid
+// is aligned in the time zone of the query whatever its offset from UTC is.
+func gapSlots(gap Interval, date time.Time, day int, duration time.Duration) []slot {
+	slots := make([]slot, 0)
+
+	first := date.Add(gap.Start.Sub(date).Truncate(DefaultSlotStep))
+	if first.Before(gap.Start) {
+		first = first.Add(DefaultSlotStep)
+	}
+
+	for start := first; !start.Add(duration).After(gap.Finish); start = start.Add(DefaultSlotStep) {
+		s := slot{Interval: Interval{Start: start, Finish: start.Add(duration)}, day: day}
+		if start.Sub(gap.Start) >= DefaultSlotStep {
+			s.loose++
+		}
+
+		if gap.Finish.Sub(s.Finish) >= DefaultSlotStep {
+			s.loose++
+		}
+
+		slots = append(slots, s)
+	}
+
+	return slots
+}
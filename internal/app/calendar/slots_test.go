@@ -0,0 +1,126 @@
+package calendar_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/app/calendar"
+	"github.com/seregproj/calendar/internal/auth"
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Warning(string) {}
+
+func (nopLogger) WarningWithFields(string, map[string]interface{}) {}
+
+func TestFindAvailableSlots(t *testing.T) {
+	ctx := auth.ContextWithPrincipal(context.Background(), &auth.Principal{UserID: "user1", Method: auth.MethodAPIKey})
+	s := memorystorage.New()
+	app := calendar.New(nopLogger{}, s, nil)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	// monday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2021, 5, 3+day, hour, minute, 0, 0, berlin)
+	}
+
+	for _, e := range []storage.Event{
+		{ID: "event1", UserID: "user1", Start: at(0, 9, 0), Finish: at(0, 10, 0)},
+		{ID: "event2", UserID: "user2", Start: at(0, 10, 30), Finish: at(0, 17, 0)},
+		{ID: "event3", UserID: "user2", Start: at(0, 17, 30), Finish: at(0, 18, 0)},
+		{ID: "event4", UserID: "user3", Start: at(0, 9, 0), Finish: at(0, 18, 0)},
+		{
+			ID: "vacation", UserID: "user4", Start: time.Date(2021, 5, 4, 0, 0, 0, 0, time.UTC),
+			Finish: time.Date(2021, 5, 5, 0, 0, 0, 0, time.UTC), AllDay: true, TimeZone: "Asia/Tokyo",
+		},
+	} {
+		e := e
+		require.NoError(t, s.CreateEvent(ctx, &e))
+	}
+
+	t.Run("test slots are ranked by day and by filling gaps", func(t *testing.T) {
+		slots, err := app.FindAvailableSlots(ctx, calendar.SlotQuery{
+			UserIDs:    []string{"user1", "user2"},
+			Duration:   30 * time.Minute,
+			From:       at(0, 0, 0),
+			To:         at(7, 0, 0),
+			TimeZone:   "Europe/Berlin",
+			MaxResults: 4,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []calendar.Interval{
+			{Start: at(0, 10, 0).UTC(), Finish: at(0, 10, 30).UTC()},
+			{Start: at(0, 17, 0).UTC(), Finish: at(0, 17, 30).UTC()},
+			{Start: at(1, 9, 0).UTC(), Finish: at(1, 9, 30).UTC()},
+			{Start: at(1, 17, 30).UTC(), Finish: at(1, 18, 0).UTC()},
+		}, slots)
+	})
+
+	t.Run("test weekends and busy days are skipped", func(t *testing.T) {
+		slots, err := app.FindAvailableSlots(ctx, calendar.SlotQuery{
+			UserIDs:       []string{"user3"},
+			Duration:      time.Hour,
+			From:          at(0, 0, 0),
+			To:            at(7, 0, 0),
+			WorkdayStart:  10 * time.Hour,
+			WorkdayFinish: 11 * time.Hour,
+			TimeZone:      "Europe/Berlin",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 4, len(slots))
+		require.Equal(t, at(1, 10, 0).UTC(), slots[0].Start)
+		require.Equal(t, at(4, 10, 0).UTC(), slots[3].Start)
+	})
+
+	t.Run("test all-day events are busy on their dates in their time zones", func(t *testing.T) {
+		slots, err := app.FindAvailableSlots(ctx, calendar.SlotQuery{
+			UserIDs:    []string{"user4"},
+			Duration:   30 * time.Minute,
+			From:       at(1, 0, 0),
+			To:         at(3, 0, 0),
+			TimeZone:   "Europe/Berlin",
+			MaxResults: 1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []calendar.Interval{{Start: at(1, 17, 0).UTC(), Finish: at(1, 17, 30).UTC()}}, slots)
+	})
+
+	t.Run("test slots are aligned in the time zone of the query", func(t *testing.T) {
+		// Amsterdam was 19 minutes 32 seconds ahead of UTC in 1930
+		amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+		require.NoError(t, err)
+
+		slots, err := app.FindAvailableSlots(ctx, calendar.SlotQuery{
+			UserIDs:    []string{"user1"},
+			Duration:   30 * time.Minute,
+			From:       time.Date(1930, 5, 6, 9, 5, 0, 0, amsterdam),
+			To:         time.Date(1930, 5, 7, 0, 0, 0, 0, amsterdam),
+			TimeZone:   "Europe/Amsterdam",
+			MaxResults: 1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, time.Date(1930, 5, 6, 9, 15, 0, 0, amsterdam).UTC(), slots[0].Start)
+	})
+
+	t.Run("test invalid query", func(t *testing.T) {
+		_, err := app.FindAvailableSlots(ctx, calendar.SlotQuery{
+			UserIDs: []string{"user1"}, Duration: 10 * time.Hour, From: at(0, 0, 0), To: at(1, 0, 0),
+		})
+		require.ErrorIs(t, err, calendar.ErrInvalidSlotQuery)
+
+		_, err = app.FindAvailableSlots(ctx, calendar.SlotQuery{
+			UserIDs: []string{"user1"}, Duration: time.Hour, From: at(1, 0, 0), To: at(0, 0, 0),
+		})
+		require.ErrorIs(t, err, calendar.ErrInvalidRange)
+
+		_, err = app.FindAvailableSlots(context.Background(), calendar.SlotQuery{})
+		require.ErrorIs(t, err, calendar.ErrUnauthenticated)
+	})
+}
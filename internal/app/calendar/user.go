@@ -0,0 +1,17 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/seregproj/calendar/internal/auth"
+)
+
+// UserIDFromContext returns the authenticated calling user, events of the app are scoped to this user.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	return principal.UserID, principal.UserID != ""
+}
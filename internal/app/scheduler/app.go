@@ -1,63 +1,202 @@
 package scheduler
 
 import (
+	"encoding/json"
 	"errors"
+	"expvar"
 	"fmt"
+	"time"
 
 	"github.com/seregproj/calendar/internal/messagebroker"
 	"github.com/seregproj/calendar/internal/storage"
 	"golang.org/x/net/context"
 )
 
-var ErrUnexpected = errors.New("unexpected error")
+var (
+	ErrUnexpected   = errors.New("unexpected error")
+	ErrInvalidLimit = errors.New("invalid limit")
+)
+
+// skippedReminders counts reminders skipped being late more than the allowed delay since start, published by expvar.
+var skippedReminders = expvar.NewInt("scheduler_skipped_reminders")
 
 type App struct {
 	logger  Logger
 	storage Storage
-	broker  MessageBroker
+	relay   *Relay
+	clock   Clock
+	// lease is how long claimed reminders are hidden from other schedulers, failed ones are retried after it
+	lease time.Duration
+	// maxDelay is how late reminders may be sent, older ones are skipped
+	maxDelay time.Duration
 }
 
-func New(logger Logger, storage Storage, broker MessageBroker) *App {
-	return &App{logger: logger, storage: storage, broker: broker}
+func New(logger Logger, storage Storage, broker MessageBroker, clock Clock, lease, maxDelay time.Duration) *App {
+	return &App{
+		logger:   logger,
+		storage:  storage,
+		relay:    NewRelay(logger, storage, broker, clock, lease),
+		clock:    clock,
+		lease:    lease,
+		maxDelay: maxDelay,
+	}
 }
 
 type Logger interface {
+	Info(text string)
 	Warning(text string)
 	WarningWithFields(text string, fields map[string]interface{})
 }
 
 type Storage interface {
-	GetUnprocessedActualEvents(ctx context.Context, limit int64) ([]*storage.Event, error)
-	UpdateEventAsProcessed(ctx context.Context, event *storage.Event) error
+	ClaimDueReminders(ctx context.Context, now time.Time, lease, maxDelay time.Duration, limit int64) (
+		[]*storage.DueReminder,
+		int64,
+		error)
+	UpdateReminderAsProcessed(ctx context.Context, reminder *storage.DueReminder,
+		outbox []*storage.OutboxMessage) error
+	GetAttendees(ctx context.Context, eventID string) ([]*storage.Attendee, error)
+	PurgeEvents(ctx context.Context, before time.Time, archive bool, limit int64) (int64, error)
+	OutboxStorage
 }
 
+// MessageBroker returns from PushNotification after the broker has taken responsibility for the notification,
+// e.g. confirmed its publishing, so the outbox message is marked as sent only then.
 type MessageBroker interface {
 	PushNotification(notification *messagebroker.Notification) error
 }
 
+// Run processes actual events and relays the outbox on the schedule until the context is canceled,
+// the first run is immediate.
+func (app *App) Run(ctx context.Context, schedule Schedule, limit int64) error {
+	if limit <= 0 {
+		return ErrInvalidLimit
+	}
+
+	app.runOnSchedule(ctx, schedule, func(ctx context.Context) {
+		if err := app.ProcessActualEvents(ctx, limit); err != nil && ctx.Err() == nil {
+			app.logger.Warning(fmt.Sprintf("cant process actual events: %v", err))
+		}
+
+		if err := app.relay.RelayOutbox(ctx, limit); err != nil && ctx.Err() == nil {
+			app.logger.Warning(fmt.Sprintf("cant relay outbox: %v", err))
+		}
+	})
+
+	app.logger.Info("scheduler is stopped")
+
+	return nil
+}
+
+// runOnSchedule runs the job immediately and then on the schedule until the context is canceled.
+func (app *App) runOnSchedule(ctx context.Context, schedule Schedule, job func(ctx context.Context)) {
+	for {
+		job(ctx)
+
+		now := app.clock.Now()
+		next := schedule.Next(now)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-app.clock.After(next.Sub(now)):
+		}
+	}
+}
+
+// ProcessActualEvents stores notifications of reminders which time has come to the outbox in batches of limit
+// until there are no due reminders left or none of the batch is processed, notifications of every reminder are
+// stored once per occurrence of the event. Reminders late more than the allowed delay are skipped and counted.
 func (app *App) ProcessActualEvents(ctx context.Context, limit int64) error {
-	events, err := app.storage.GetUnprocessedActualEvents(ctx, limit)
+	for ctx.Err() == nil {
+		found, sent, err := app.processBatch(ctx, limit)
+		if err != nil {
+			return err
+		}
+
+		if found < limit || sent == 0 {
+			break
+		}
+	}
+
+	return nil
+}
+
+// processBatch processes due reminders at most of limit, it returns number of found and processed reminders,
+// skipped reminders are taken as found and processed.
+func (app *App) processBatch(ctx context.Context, limit int64) (int64, int64, error) {
+	reminders, skipped, err := app.storage.ClaimDueReminders(ctx, app.clock.Now(), app.lease, app.maxDelay, limit)
 	if err != nil {
-		app.logger.Warning(fmt.Sprintf("cant get unprocessed actual events with err: %v", err.Error()))
+		app.logger.Warning(fmt.Sprintf("cant claim due reminders with err: %v", err.Error()))
+
+		return 0, 0, ErrUnexpected
+	}
 
-		return ErrUnexpected
+	if skipped > 0 {
+		skippedReminders.Add(skipped)
+		app.logger.Warning(fmt.Sprintf("%d reminders are skipped being late more than %v", skipped, app.maxDelay))
 	}
 
-	for _, event := range events {
-		if err := app.broker.PushNotification(messagebroker.NewNotification(event.ID, event.Title, event.Start)); err != nil {
-			app.logger.WarningWithFields(fmt.Sprintf("cant push event to message broker: %v", err), map[string]interface{}{
-				"event": event,
+	var processed int64
+
+	for _, reminder := range reminders {
+		outbox, err := app.outboxMessages(ctx, reminder)
+		if err != nil {
+			app.logger.WarningWithFields(fmt.Sprintf("cant prepare notifications: %v", err), map[string]interface{}{
+				"event":  reminder.Occurrence,
+				"offset": reminder.Offset,
 			})
 
 			continue
 		}
 
-		if err := app.storage.UpdateEventAsProcessed(ctx, event); err != nil {
-			app.logger.WarningWithFields(fmt.Sprintf("cant set event as processed: %v", err), map[string]interface{}{
-				"event": event,
+		if err := app.storage.UpdateReminderAsProcessed(ctx, reminder, outbox); err != nil {
+			app.logger.WarningWithFields(fmt.Sprintf("cant set reminder as processed: %v", err), map[string]interface{}{
+				"event":  reminder.Occurrence,
+				"offset": reminder.Offset,
 			})
+
+			continue
 		}
+
+		processed++
 	}
 
-	return nil
+	return int64(len(reminders)) + skipped, processed + skipped, nil
+}
+
+// outboxMessages returns notifications of the reminder to the owner and to every attendee who has not declined.
+func (app *App) outboxMessages(ctx context.Context, reminder *storage.DueReminder) ([]*storage.OutboxMessage, error) {
+	event := reminder.Occurrence
+
+	attendees, err := app.storage.GetAttendees(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cant get attendees: %w", err)
+	}
+
+	notifications := []*messagebroker.Notification{
+		messagebroker.NewNotification(event.ID, event.Title, event.Start, event.UserID, ""),
+	}
+	for _, a := range attendees {
+		if a.IsNotified() {
+			notifications = append(notifications,
+				messagebroker.NewNotification(event.ID, event.Title, event.Start, a.UserID, a.Email))
+		}
+	}
+
+	messages := make([]*storage.OutboxMessage, 0, len(notifications))
+	for _, n := range notifications {
+		n.TimeZone = event.TimeZone
+		n.Key = fmt.Sprintf("%s/%d/%s/%s", event.ID, int64(reminder.Offset/time.Minute),
+			event.Start.UTC().Format(time.RFC3339), n.UserID)
+
+		payload, err := json.Marshal(n)
+		if err != nil {
+			return nil, fmt.Errorf("cant marshal notification: %w", err)
+		}
+
+		messages = append(messages, &storage.OutboxMessage{Key: n.Key, Payload: payload})
+	}
+
+	return messages, nil
 }
@@ -0,0 +1,358 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/app/scheduler"
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+const lease = 5 * time.Minute
+
+type nopLogger struct{}
+
+func (nopLogger) Info(string)                                      {}
+func (nopLogger) Warning(string)                                   {}
+func (nopLogger) WarningWithFields(string, map[string]interface{}) {}
+
+type fakeBroker struct {
+	sync.Mutex
+	notifications []*messagebroker.Notification
+	err           error
+}
+
+func (b *fakeBroker) PushNotification(n *messagebroker.Notification) error {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.err != nil {
+		return b.err
+	}
+
+	b.notifications = append(b.notifications, n)
+
+	return nil
+}
+
+func (b *fakeBroker) count() int {
+	b.Lock()
+	defer b.Unlock()
+
+	return len(b.notifications)
+}
+
+// fakeClock moves only by Advance, waits of the scheduler are reported to waiting.
+type fakeClock struct {
+	sync.Mutex
+	now     time.Time
+	timers  []fakeTimer
+	waiting chan time.Duration
+}
+
+type fakeTimer struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now, waiting: make(chan time.Duration, 10)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.Lock()
+	defer c.Unlock()
+
+	ch := make(chan time.Time, 1)
+	c.timers = append(c.timers, fakeTimer{at: c.now.Add(d), ch: ch})
+	c.waiting <- d
+
+	return ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.now = c.now.Add(d)
+
+	timers := c.timers[:0]
+	for _, t := range c.timers {
+		if t.at.After(c.now) {
+			timers = append(timers, t)
+
+			continue
+		}
+
+		t.ch <- c.now
+	}
+
+	c.timers = timers
+}
+
+func createEvents(t *testing.T, s *memorystorage.Storage, start time.Time, count int) {
+	t.Helper()
+
+	for i := 0; i < count; i++ {
+		event := storage.Event{
+			ID: start.Format(time.RFC3339) + string(rune('a'+i)), UserID: "user1", Start: start,
+			Finish: start.Add(time.Hour), Reminders: []time.Duration{0},
+		}
+		require.NoError(t, s.CreateEvent(context.Background(), &event))
+	}
+}
+
+func TestRun(t *testing.T) {
+	now := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+
+	t.Run("backlog is drained in batches and new reminders are sent on schedule", func(t *testing.T) {
+		clock := newFakeClock(now)
+		s := memorystorage.New()
+		broker := &fakeBroker{}
+		createEvents(t, s, now, 7)
+		createEvents(t, s, now.Add(5*time.Minute), 2)
+
+		app := scheduler.New(nopLogger{}, s, broker, clock, lease, storage.DefaultReminderDelay)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+
+		go func() {
+			done <- app.Run(ctx, scheduler.IntervalSchedule(time.Minute), 3)
+		}()
+
+		require.Equal(t, time.Minute, <-clock.waiting)
+		require.Equal(t, 7, broker.count())
+
+		for i := 0; i < 4; i++ {
+			clock.Advance(time.Minute)
+			<-clock.waiting
+			require.Equal(t, 7, broker.count())
+		}
+
+		clock.Advance(time.Minute)
+		<-clock.waiting
+		require.Equal(t, 9, broker.count())
+
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	t.Run("failing broker does not loop", func(t *testing.T) {
+		clock := newFakeClock(now)
+		s := memorystorage.New()
+		broker := &fakeBroker{err: errors.New("broker is down")}
+		createEvents(t, s, now, 5)
+
+		app := scheduler.New(nopLogger{}, s, broker, clock, lease, storage.DefaultReminderDelay)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+
+		go func() {
+			done <- app.Run(ctx, scheduler.IntervalSchedule(time.Minute), 2)
+		}()
+
+		<-clock.waiting
+		require.Equal(t, 0, broker.count())
+
+		broker.Lock()
+		broker.err = nil
+		broker.Unlock()
+
+		// the batch which failed is retried after the lease, the rest is sent on the next run
+		for i := 0; i < 4; i++ {
+			clock.Advance(time.Minute)
+			<-clock.waiting
+			require.Equal(t, 3, broker.count())
+		}
+
+		clock.Advance(time.Minute)
+		<-clock.waiting
+		require.Equal(t, 5, broker.count())
+
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	t.Run("cron schedule", func(t *testing.T) {
+		clock := newFakeClock(now.Add(20 * time.Second))
+		schedule, err := scheduler.NewSchedule(0, "*/5 * * * *")
+		require.NoError(t, err)
+
+		app := scheduler.New(nopLogger{}, memorystorage.New(), &fakeBroker{}, clock, lease, storage.DefaultReminderDelay)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+
+		go func() {
+			done <- app.Run(ctx, schedule, 10)
+		}()
+
+		require.Equal(t, 5*time.Minute-20*time.Second, <-clock.waiting)
+
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		app := scheduler.New(nopLogger{}, memorystorage.New(), &fakeBroker{}, newFakeClock(now), lease, storage.DefaultReminderDelay)
+
+		err := app.Run(context.Background(), scheduler.IntervalSchedule(time.Minute), 0)
+		require.ErrorIs(t, err, scheduler.ErrInvalidLimit)
+	})
+}
+
+func TestProcessActualEventsAndRelayConcurrently(t *testing.T) {
+	now := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	s := memorystorage.New()
+	broker := &fakeBroker{}
+	createEvents(t, s, now, 20)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			clock := newFakeClock(now)
+			app := scheduler.New(nopLogger{}, s, broker, clock, lease, storage.DefaultReminderDelay)
+			require.NoError(t, app.ProcessActualEvents(context.Background(), 3))
+
+			relay := scheduler.NewRelay(nopLogger{}, s, broker, clock, lease)
+			require.NoError(t, relay.RelayOutbox(context.Background(), 3))
+		}()
+	}
+
+	wg.Wait()
+
+	seen := make(map[string]bool)
+	for _, n := range broker.notifications {
+		require.False(t, seen[n.EventID], "notified twice about %s", n.EventID)
+		seen[n.EventID] = true
+	}
+
+	require.Len(t, seen, 20)
+}
+
+func TestRelayOutbox(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	s := memorystorage.New()
+	createEvents(t, s, now, 1)
+	require.NoError(t, s.SaveAttendee(ctx, &storage.Attendee{
+		EventID: now.Format(time.RFC3339) + "a", UserID: "user2", Email: "user2@example.com",
+		Role: storage.RoleRequired, Status: storage.StatusAccepted,
+	}))
+
+	broker := &fakeBroker{}
+	clock := newFakeClock(now)
+	app := scheduler.New(nopLogger{}, s, broker, clock, lease, storage.DefaultReminderDelay)
+	require.NoError(t, app.ProcessActualEvents(ctx, 10))
+	require.Equal(t, 0, broker.count(), "notifications wait in the outbox")
+
+	// processed reminder is not claimed again
+	due, _, err := s.ClaimDueReminders(ctx, now, lease, time.Hour, 10)
+	require.NoError(t, err)
+	require.Empty(t, due)
+
+	relay := scheduler.NewRelay(nopLogger{}, s, broker, clock, lease)
+	require.NoError(t, relay.RelayOutbox(ctx, 10))
+	require.Equal(t, 2, broker.count())
+	require.Equal(t, "user1", broker.notifications[0].UserID)
+	require.Equal(t, "user2", broker.notifications[1].UserID)
+	require.Equal(t, "user2@example.com", broker.notifications[1].Email)
+	require.NotEqual(t, broker.notifications[0].Key, broker.notifications[1].Key)
+
+	require.NoError(t, relay.RelayOutbox(ctx, 10))
+	require.Equal(t, 2, broker.count())
+}
+
+func TestNewSchedule(t *testing.T) {
+	schedule, err := scheduler.NewSchedule(time.Minute, "")
+	require.NoError(t, err)
+	require.Equal(t, scheduler.IntervalSchedule(time.Minute), schedule)
+
+	_, err = scheduler.NewSchedule(0, "")
+	require.ErrorIs(t, err, scheduler.ErrInvalidSchedule)
+
+	_, err = scheduler.NewSchedule(time.Minute, "not a cron")
+	require.ErrorIs(t, err, scheduler.ErrInvalidSchedule)
+}
+
+func TestPurgeEvents(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	s := memorystorage.New()
+	createEvents(t, s, now.AddDate(0, 0, -40), 5)
+	createEvents(t, s, now.AddDate(0, 0, -20), 1)
+
+	metric := expvar.Get("scheduler_purged_events").(*expvar.Int)
+	purgedBefore := metric.Value()
+
+	app := scheduler.New(nopLogger{}, s, &fakeBroker{}, newFakeClock(now), lease, storage.DefaultReminderDelay)
+	purged, err := app.PurgeEvents(ctx, scheduler.RetentionPolicy{Age: 30 * 24 * time.Hour}, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), purged)
+	require.Equal(t, purgedBefore+5, metric.Value())
+
+	purged, err = app.PurgeEvents(ctx, scheduler.RetentionPolicy{Age: 30 * 24 * time.Hour}, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), purged)
+
+	err = app.RunPurge(ctx, scheduler.IntervalSchedule(time.Hour), scheduler.RetentionPolicy{}, 2)
+	require.ErrorIs(t, err, scheduler.ErrInvalidRetention)
+}
+
+func TestSkippedReminders(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+
+	metric := expvar.Get("scheduler_skipped_reminders").(*expvar.Int)
+
+	t.Run("late reminders are skipped and counted", func(t *testing.T) {
+		s := memorystorage.New()
+		createEvents(t, s, now.Add(-2*time.Hour), 5)
+		createEvents(t, s, now, 1)
+		skippedBefore := metric.Value()
+
+		broker := &fakeBroker{}
+		clock := newFakeClock(now)
+		app := scheduler.New(nopLogger{}, s, broker, clock, lease, time.Hour)
+		require.NoError(t, app.ProcessActualEvents(ctx, 2))
+		require.Equal(t, skippedBefore+5, metric.Value())
+
+		require.NoError(t, scheduler.NewRelay(nopLogger{}, s, broker, clock, lease).RelayOutbox(ctx, 10))
+		require.Equal(t, 1, broker.count())
+
+		require.NoError(t, app.ProcessActualEvents(ctx, 2))
+		require.Equal(t, skippedBefore+5, metric.Value(), "skipped reminders are counted once")
+	})
+
+	t.Run("delay is configurable", func(t *testing.T) {
+		s := memorystorage.New()
+		createEvents(t, s, now.Add(-2*time.Hour), 5)
+		skippedBefore := metric.Value()
+
+		broker := &fakeBroker{}
+		clock := newFakeClock(now)
+		app := scheduler.New(nopLogger{}, s, broker, clock, lease, 3*time.Hour)
+		require.NoError(t, app.ProcessActualEvents(ctx, 2))
+		require.Equal(t, skippedBefore, metric.Value())
+
+		require.NoError(t, scheduler.NewRelay(nopLogger{}, s, broker, clock, lease).RelayOutbox(ctx, 10))
+		require.Equal(t, 5, broker.count())
+	})
+}
@@ -0,0 +1,80 @@
+package scheduler
+
+import (
+	"errors"
+	"expvar"
+	"fmt"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+var ErrInvalidRetention = errors.New("invalid retention policy")
+
+// counters of purged events since start, published by expvar.
+var (
+	purgedEvents   = expvar.NewInt("scheduler_purged_events")
+	archivedEvents = expvar.NewInt("scheduler_archived_events")
+)
+
+// RetentionPolicy describes which events are purged.
+type RetentionPolicy struct {
+	// Age is how long events are kept after their finish.
+	Age time.Duration
+	// Archive moves purged events to archive instead of dropping them.
+	Archive bool
+}
+
+// RunPurge purges old events on the schedule until the context is canceled, the first run is immediate.
+func (app *App) RunPurge(ctx context.Context, schedule Schedule, policy RetentionPolicy, limit int64) error {
+	if limit <= 0 {
+		return ErrInvalidLimit
+	}
+
+	if policy.Age <= 0 {
+		return ErrInvalidRetention
+	}
+
+	app.runOnSchedule(ctx, schedule, func(ctx context.Context) {
+		if _, err := app.PurgeEvents(ctx, policy, limit); err != nil && ctx.Err() == nil {
+			app.logger.Warning(fmt.Sprintf("cant purge events: %v", err))
+		}
+	})
+
+	app.logger.Info("purge is stopped")
+
+	return nil
+}
+
+// PurgeEvents deletes events finished before the retention age in batches of limit until none is left,
+// it returns number of purged events.
+func (app *App) PurgeEvents(ctx context.Context, policy RetentionPolicy, limit int64) (int64, error) {
+	before := app.clock.Now().Add(-policy.Age)
+
+	var total int64
+
+	for ctx.Err() == nil {
+		purged, err := app.storage.PurgeEvents(ctx, before, policy.Archive, limit)
+		if err != nil {
+			app.logger.Warning(fmt.Sprintf("cant purge events with err: %v", err.Error()))
+
+			return total, ErrUnexpected
+		}
+
+		total += purged
+		purgedEvents.Add(purged)
+
+		if policy.Archive {
+			archivedEvents.Add(purged)
+		}
+
+		if purged < limit {
+			break
+		}
+	}
+
+	app.logger.Info(fmt.Sprintf("purged %d events finished before %s, archived: %v", total,
+		before.Format(time.RFC3339), policy.Archive))
+
+	return total, nil
+}
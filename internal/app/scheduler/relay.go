@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/storage"
+	"golang.org/x/net/context"
+)
+
+type OutboxStorage interface {
+	ClaimOutbox(ctx context.Context, now time.Time, lease time.Duration, limit int64) ([]*storage.OutboxMessage, error)
+	UpdateOutboxAsSent(ctx context.Context, id int64) error
+}
+
+// Relay publishes outbox messages to the broker, a message is published at least once,
+// it is published again when the relay fails to mark it as sent.
+type Relay struct {
+	logger  Logger
+	storage OutboxStorage
+	broker  MessageBroker
+	clock   Clock
+	// lease is how long claimed messages are hidden from other relays, failed ones are retried after it
+	lease time.Duration
+}
+
+func NewRelay(logger Logger, storage OutboxStorage, broker MessageBroker, clock Clock, lease time.Duration) *Relay {
+	return &Relay{logger: logger, storage: storage, broker: broker, clock: clock, lease: lease}
+}
+
+// RelayOutbox publishes unsent outbox messages in batches of limit until there are no messages left
+// or none of the batch is published.
+func (r *Relay) RelayOutbox(ctx context.Context, limit int64) error {
+	for ctx.Err() == nil {
+		found, sent, err := r.relayBatch(ctx, limit)
+		if err != nil {
+			return err
+		}
+
+		if found < limit || sent == 0 {
+			break
+		}
+	}
+
+	return nil
+}
+
+// relayBatch publishes outbox messages at most of limit, it returns number of found and published messages.
+func (r *Relay) relayBatch(ctx context.Context, limit int64) (int64, int64, error) {
+	messages, err := r.storage.ClaimOutbox(ctx, r.clock.Now(), r.lease, limit)
+	if err != nil {
+		r.logger.Warning(fmt.Sprintf("cant claim outbox with err: %v", err.Error()))
+
+		return 0, 0, ErrUnexpected
+	}
+
+	var sent int64
+
+	for _, m := range messages {
+		notification := &messagebroker.Notification{}
+		if err := json.Unmarshal(m.Payload, notification); err != nil {
+			r.logger.WarningWithFields(fmt.Sprintf("cant unmarshal outbox message: %v", err), map[string]interface{}{
+				"key": m.Key,
+			})
+
+			continue
+		}
+
+		if err := r.broker.PushNotification(notification); err != nil {
+			r.logger.WarningWithFields(fmt.Sprintf("cant push notification to message broker: %v", err),
+				map[string]interface{}{
+					"key": m.Key,
+				})
+
+			continue
+		}
+
+		if err := r.storage.UpdateOutboxAsSent(ctx, m.ID); err != nil {
+			r.logger.WarningWithFields(fmt.Sprintf("cant set outbox message as sent: %v", err), map[string]interface{}{
+				"key": m.Key,
+			})
+
+			continue
+		}
+
+		sent++
+	}
+
+	return int64(len(messages)), sent, nil
+}
@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// Clock is the source of time of the scheduler, it is faked in tests.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Schedule returns time of the next run after the given one.
+type Schedule interface {
+	Next(t time.Time) time.Time
+}
+
+// IntervalSchedule runs every interval after the previous run.
+type IntervalSchedule time.Duration
+
+func (s IntervalSchedule) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(s))
+}
+
+// NewSchedule returns schedule of the standard cron expression if it is set, otherwise of the interval.
+func NewSchedule(interval time.Duration, spec string) (Schedule, error) {
+	if spec != "" {
+		schedule, err := cron.ParseStandard(spec)
+		if err != nil {
+			return nil, fmt.Errorf("cron %q: %v: %w", spec, err, ErrInvalidSchedule)
+		}
+
+		return schedule, nil
+	}
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("interval %v: %w", interval, ErrInvalidSchedule)
+	}
+
+	return IntervalSchedule(interval), nil
+}
@@ -6,17 +6,20 @@ import (
 	"fmt"
 
 	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/notify"
 )
 
 var ErrUnexpected = errors.New("unexpected error")
 
 type App struct {
-	logger Logger
-	broker MessageBroker
+	logger    Logger
+	broker    MessageBroker
+	router    *Router
+	templates *Templates
 }
 
-func New(logger Logger, broker MessageBroker) *App {
-	return &App{logger: logger, broker: broker}
+func New(logger Logger, broker MessageBroker, router *Router, templates *Templates) *App {
+	return &App{logger: logger, broker: broker, router: router, templates: templates}
 }
 
 type Logger interface {
@@ -26,11 +29,14 @@ type Logger interface {
 }
 
 type MessageBroker interface {
-	ConsumeNotifications(ctx context.Context) (<-chan messagebroker.Notification, error)
+	ConsumeNotifications(ctx context.Context) (<-chan messagebroker.Delivery, error)
 }
 
+// SendNotifications delivers consumed notifications and settles them with the broker: delivered ones are acked,
+// permanently failed ones are dead-lettered, the rest are retried by failed channels only. Notifications interrupted
+// by the shutdown are requeued.
 func (app *App) SendNotifications(ctx context.Context) error {
-	notifs, err := app.broker.ConsumeNotifications(ctx)
+	deliveries, err := app.broker.ConsumeNotifications(ctx)
 	if err != nil {
 		app.logger.Warning(fmt.Sprintf("cant consume notifs with err: %v", err))
 
@@ -38,9 +44,93 @@ func (app *App) SendNotifications(ctx context.Context) error {
 	}
 
 	app.logger.Info("ready to send notifications..")
-	for n := range notifs {
-		app.logger.Info(fmt.Sprintf("sent notif: %v", n))
+	for d := range deliveries {
+		d := d
+		delivered, err := app.Deliver(ctx, &d.Notification, d.Channel, d.Delivered)
+		app.settle(ctx, &d, delivered, err)
 	}
 
 	return nil
 }
+
+func (app *App) settle(ctx context.Context, d *messagebroker.Delivery, delivered []string, deliverErr error) {
+	var (
+		deliveryErr *DeliveryError
+		err         error
+	)
+
+	fields := map[string]interface{}{
+		"key":     d.Key,
+		"userID":  d.UserID,
+		"attempt": d.Attempt,
+	}
+
+	switch {
+	case deliverErr == nil:
+		app.logger.Info(fmt.Sprintf("sent notif: %v", d.Key))
+
+		err = d.Ack()
+	case ctx.Err() != nil:
+		app.logger.WarningWithFields(fmt.Sprintf("interrupted: %v", deliverErr), fields)
+
+		err = d.Requeue()
+	case errors.As(deliverErr, &deliveryErr) && deliveryErr.Permanent():
+		app.logger.WarningWithFields(fmt.Sprintf("dead-lettered: %v", deliverErr), fields)
+
+		err = d.Reject()
+	default:
+		app.logger.WarningWithFields(fmt.Sprintf("retried: %v", deliverErr), fields)
+
+		err = d.Retry(delivered)
+	}
+
+	if err != nil {
+		app.logger.WarningWithFields(fmt.Sprintf("cant settle notif: %v", err), fields)
+	}
+}
+
+// Deliver renders the notification and sends it by every channel of the recipient except delivered ones, or by
+// the channel only when it is set, channels without address of the recipient are skipped, failures of the rest
+// are returned as DeliveryError. Names of channels which delivered the notification, given ones included,
+// are returned.
+func (app *App) Deliver(ctx context.Context, notification *messagebroker.Notification, channel string,
+	delivered []string) ([]string, error) {
+	skip := make(map[string]bool, len(delivered))
+	for _, name := range delivered {
+		skip[name] = true
+	}
+
+	delivered = append([]string{}, delivered...)
+	failed := make(map[string]error)
+	recipient := app.router.RecipientOf(notification.UserID)
+
+	for _, c := range app.router.ChannelsOf(notification) {
+		if skip[c.Name()] || (channel != "" && c.Name() != channel) {
+			continue
+		}
+
+		message, err := app.templates.Render(c.Name(), recipient, notification)
+		if err != nil {
+			failed[c.Name()] = err
+
+			continue
+		}
+
+		err = c.Send(ctx, notification, message)
+
+		switch {
+		case errors.Is(err, notify.ErrNoAddress):
+			app.logger.Info(fmt.Sprintf("skip channel %s of notif %s: %v", c.Name(), notification.Key, err))
+		case err != nil:
+			failed[c.Name()] = err
+		default:
+			delivered = append(delivered, c.Name())
+		}
+	}
+
+	if len(failed) > 0 {
+		return delivered, &DeliveryError{Errors: failed}
+	}
+
+	return delivered, nil
+}
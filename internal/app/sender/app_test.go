@@ -0,0 +1,310 @@
+package sender_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/app/sender"
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/notify"
+	"github.com/seregproj/calendar/internal/storage"
+	"github.com/stretchr/testify/require"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Info(string)                                      {}
+func (nopLogger) Warning(string)                                   {}
+func (nopLogger) WarningWithFields(string, map[string]interface{}) {}
+
+type fakeChannel struct {
+	name     string
+	err      error
+	sent     []*messagebroker.Notification
+	messages []*notify.Message
+}
+
+func (c *fakeChannel) Name() string {
+	return c.name
+}
+
+func (c *fakeChannel) Send(ctx context.Context, n *messagebroker.Notification, m *notify.Message) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	c.sent = append(c.sent, n)
+	c.messages = append(c.messages, m)
+
+	return nil
+}
+
+// writeTemplates writes templates of channels to the temp dir, files are given by paths relative to the dir.
+func writeTemplates(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0o600))
+	}
+
+	return dir
+}
+
+func loadTemplates(t *testing.T, channels ...string) *sender.Templates {
+	t.Helper()
+
+	files := make(map[string]string)
+	for _, c := range channels {
+		files[c+"/en.txt"] = `{{define "subject"}}{{.Title}}{{end}}{{define "body"}}{{.Start.Format "15:04"}}{{end}}`
+	}
+
+	templates, err := sender.LoadTemplates(writeTemplates(t, files), "en", "", channels)
+	require.NoError(t, err)
+
+	return templates
+}
+
+func TestNewRouter(t *testing.T) {
+	channels := []sender.Channel{&fakeChannel{name: "file"}, &fakeChannel{name: "email"}}
+
+	_, err := sender.NewRouter(channels, []string{"file"},
+		map[string]sender.Recipient{"user1": {Channels: []string{"email"}}})
+	require.NoError(t, err)
+
+	_, err = sender.NewRouter(channels, nil, nil)
+	require.ErrorIs(t, err, sender.ErrUnknownChannel)
+
+	_, err = sender.NewRouter(channels, []string{"sms"}, nil)
+	require.ErrorIs(t, err, sender.ErrUnknownChannel)
+
+	_, err = sender.NewRouter(channels, []string{"file"},
+		map[string]sender.Recipient{"user1": {Channels: []string{"sms"}}})
+	require.ErrorIs(t, err, sender.ErrUnknownChannel)
+
+	_, err = sender.NewRouter(channels, []string{"file"}, map[string]sender.Recipient{"user1": {TimeZone: "Mars/Base"}})
+	require.ErrorIs(t, err, storage.ErrInvalidTimeZone)
+}
+
+func TestRestrict(t *testing.T) {
+	file, email := &fakeChannel{name: "file"}, &fakeChannel{name: "email"}
+	router, err := sender.NewRouter([]sender.Channel{file, email}, []string{"file", "email"}, nil)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, router.Restrict([]string{"sms"}), sender.ErrUnknownChannel)
+	require.NoError(t, router.Restrict([]string{"email"}))
+
+	n := messagebroker.NewNotification("event1", "a", time.Now(), "user1", "")
+	require.Equal(t, []sender.Channel{email}, router.ChannelsOf(n))
+
+	require.NoError(t, router.Delivers([]string{"email"}))
+	require.ErrorIs(t, router.Delivers([]string{"email", "file"}), sender.ErrUnknownChannel)
+	require.ErrorIs(t, router.Delivers([]string{"sms"}), sender.ErrUnknownChannel)
+}
+
+func TestDeliver(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+
+	t.Run("notification is sent by channels of the recipient", func(t *testing.T) {
+		file, email, webhook := &fakeChannel{name: "file"}, &fakeChannel{name: "email"}, &fakeChannel{name: "webhook"}
+		router, err := sender.NewRouter([]sender.Channel{file, email, webhook}, []string{"file"},
+			map[string]sender.Recipient{
+				"user2": {Channels: []string{"email", "webhook"}, TimeZone: "Europe/Moscow"},
+			})
+		require.NoError(t, err)
+
+		app := sender.New(nopLogger{}, nil, router, loadTemplates(t, "file", "email", "webhook"))
+		delivered, err := app.Deliver(ctx, messagebroker.NewNotification("event1", "a", start, "user1", ""), "", nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"file"}, delivered)
+
+		delivered, err = app.Deliver(ctx, messagebroker.NewNotification("event1", "a", start, "user2", "u2@x"), "", nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"email", "webhook"}, delivered)
+
+		require.Len(t, file.sent, 1)
+		require.Equal(t, "user1", file.sent[0].UserID)
+		require.Equal(t, &notify.Message{Subject: "a", Body: "10:00"}, file.messages[0])
+		require.Len(t, email.sent, 1)
+		require.Equal(t, &notify.Message{Subject: "a", Body: "13:00"}, email.messages[0])
+		require.Len(t, webhook.sent, 1)
+	})
+
+	t.Run("errors of channels are returned, channels without address are skipped", func(t *testing.T) {
+		email := &fakeChannel{name: "email", err: fmt.Errorf("user1: %w", notify.ErrNoAddress)}
+		webhook := &fakeChannel{name: "webhook", err: errors.New("status 502")}
+		file := &fakeChannel{name: "file"}
+		router, err := sender.NewRouter([]sender.Channel{file, email, webhook}, []string{"email", "file"},
+			map[string]sender.Recipient{"user2": {Channels: []string{"webhook", "file"}}})
+		require.NoError(t, err)
+
+		app := sender.New(nopLogger{}, nil, router, loadTemplates(t, "file", "email", "webhook"))
+		delivered, err := app.Deliver(ctx, messagebroker.NewNotification("event1", "a", start, "user1", ""), "", nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"file"}, delivered)
+
+		delivered, err = app.Deliver(ctx, messagebroker.NewNotification("event1", "a", start, "user2", ""), "", nil)
+
+		var deliveryErr *sender.DeliveryError
+		require.ErrorAs(t, err, &deliveryErr)
+		require.Len(t, deliveryErr.Errors, 1)
+		require.EqualError(t, deliveryErr.Errors["webhook"], "status 502")
+		require.Len(t, file.sent, 2)
+		require.Equal(t, []string{"file"}, delivered)
+	})
+
+	t.Run("channels which delivered the notification before are skipped", func(t *testing.T) {
+		file, webhook := &fakeChannel{name: "file"}, &fakeChannel{name: "webhook"}
+		router, err := sender.NewRouter([]sender.Channel{file, webhook}, []string{"file", "webhook"}, nil)
+		require.NoError(t, err)
+
+		app := sender.New(nopLogger{}, nil, router, loadTemplates(t, "file", "webhook"))
+		delivered, err := app.Deliver(ctx, messagebroker.NewNotification("event1", "a", start, "user1", ""), "",
+			[]string{"file"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"file", "webhook"}, delivered)
+		require.Empty(t, file.sent)
+		require.Len(t, webhook.sent, 1)
+	})
+
+	t.Run("copy of the notification is sent by its channel only", func(t *testing.T) {
+		file, webhook := &fakeChannel{name: "file"}, &fakeChannel{name: "webhook"}
+		router, err := sender.NewRouter([]sender.Channel{file, webhook}, []string{"file", "webhook"}, nil)
+		require.NoError(t, err)
+
+		app := sender.New(nopLogger{}, nil, router, loadTemplates(t, "file", "webhook"))
+		delivered, err := app.Deliver(ctx, messagebroker.NewNotification("event1", "a", start, "user1", ""),
+			"webhook", nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"webhook"}, delivered)
+		require.Empty(t, file.sent)
+		require.Len(t, webhook.sent, 1)
+	})
+}
+
+type fakeBroker struct {
+	deliveries []messagebroker.Delivery
+}
+
+func (b *fakeBroker) ConsumeNotifications(ctx context.Context) (<-chan messagebroker.Delivery, error) {
+	ch := make(chan messagebroker.Delivery, len(b.deliveries))
+	for _, d := range b.deliveries {
+		ch <- d
+	}
+
+	close(ch)
+
+	return ch, nil
+}
+
+// fakeAcknowledger records how the delivery is settled.
+type fakeAcknowledger struct {
+	settled   string
+	delivered []string
+}
+
+func (a *fakeAcknowledger) Ack() error     { return a.settle("ack") }
+func (a *fakeAcknowledger) Requeue() error { return a.settle("requeue") }
+func (a *fakeAcknowledger) Reject() error  { return a.settle("reject") }
+
+func (a *fakeAcknowledger) Retry(delivered []string) error {
+	a.delivered = delivered
+
+	return a.settle("retry")
+}
+
+func (a *fakeAcknowledger) settle(how string) error {
+	if a.settled != "" {
+		return errors.New("settled twice")
+	}
+
+	a.settled = how
+
+	return nil
+}
+
+func TestSendNotifications(t *testing.T) {
+	start := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	file := &fakeChannel{name: "file"}
+	webhook := &fakeChannel{name: "webhook", err: errors.New("status 502")}
+	email := &fakeChannel{name: "email", err: fmt.Errorf("status 550: %w", notify.ErrUndeliverable)}
+	router, err := sender.NewRouter([]sender.Channel{file, webhook, email}, []string{"file"},
+		map[string]sender.Recipient{
+			"user2": {Channels: []string{"file", "webhook"}},
+			"user3": {Channels: []string{"email"}},
+		})
+	require.NoError(t, err)
+
+	acks := make(map[string]*fakeAcknowledger)
+	broker := &fakeBroker{}
+	for _, userID := range []string{"user1", "user2", "user3"} {
+		acks[userID] = &fakeAcknowledger{}
+		broker.deliveries = append(broker.deliveries, messagebroker.Delivery{
+			Notification: *messagebroker.NewNotification("event1", "a", start, userID, ""),
+			Attempt:      1,
+			Acknowledger: acks[userID],
+		})
+	}
+
+	t.Run("delivered notifications are acked, failed ones are retried or dead-lettered", func(t *testing.T) {
+		app := sender.New(nopLogger{}, broker, router, loadTemplates(t, "file", "webhook", "email"))
+		require.NoError(t, app.SendNotifications(context.Background()))
+
+		require.Equal(t, "ack", acks["user1"].settled)
+		require.Equal(t, "retry", acks["user2"].settled)
+		require.Equal(t, []string{"file"}, acks["user2"].delivered, "only the failed channel is retried")
+		require.Equal(t, "reject", acks["user3"].settled)
+	})
+
+	t.Run("interrupted notifications are requeued", func(t *testing.T) {
+		for _, a := range acks {
+			a.settled = ""
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		app := sender.New(nopLogger{}, broker, router, loadTemplates(t, "file", "webhook", "email"))
+		require.NoError(t, app.SendNotifications(ctx))
+
+		require.Equal(t, "ack", acks["user1"].settled)
+		require.Equal(t, "requeue", acks["user2"].settled)
+		require.Equal(t, "requeue", acks["user3"].settled)
+	})
+}
+
+func TestSendNotificationsRestricted(t *testing.T) {
+	file, email := &fakeChannel{name: "file"}, &fakeChannel{name: "email"}
+	router, err := sender.NewRouter([]sender.Channel{file, email}, []string{"file"},
+		map[string]sender.Recipient{"user2": {Channels: []string{"email"}}})
+	require.NoError(t, err)
+	require.NoError(t, router.Restrict([]string{"email"}))
+
+	start := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	acks := map[string]*fakeAcknowledger{"user1": {}, "user2": {}}
+	broker := &fakeBroker{}
+	for _, userID := range []string{"user1", "user2"} {
+		broker.deliveries = append(broker.deliveries, messagebroker.Delivery{
+			Notification: *messagebroker.NewNotification("event1", "a", start, userID, ""),
+			Attempt:      1,
+			Acknowledger: acks[userID],
+		})
+	}
+
+	app := sender.New(nopLogger{}, broker, router, loadTemplates(t, "file", "email"))
+	require.NoError(t, app.SendNotifications(context.Background()))
+
+	require.Equal(t, "ack", acks["user1"].settled, "notification of other channels is acked without delivery")
+	require.Empty(t, file.sent)
+	require.Equal(t, "ack", acks["user2"].settled)
+	require.Len(t, email.sent, 1)
+}
@@ -0,0 +1,160 @@
+package sender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/notify"
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+var ErrUnknownChannel = errors.New("unknown channel")
+
+// Channel delivers notifications to recipients, e.g. by email.
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, notification *messagebroker.Notification, message *notify.Message) error
+}
+
+// Recipient holds preferences of the user, empty ones are taken by default.
+type Recipient struct {
+	Channels []string
+	Locale   string
+	TimeZone string
+}
+
+// Router selects channels of the recipient, users without preferences get notifications by default channels.
+type Router struct {
+	channels map[string]Channel
+	defaults []string
+	users    map[string]Recipient
+	// only limits channels notifications are delivered by, all channels are used when nil
+	only map[string]bool
+}
+
+// NewRouter checks all channels of defaults and user preferences are known and time zones of users are valid.
+func NewRouter(channels []Channel, defaults []string, users map[string]Recipient) (*Router, error) {
+	r := &Router{channels: make(map[string]Channel, len(channels)), defaults: defaults, users: users}
+	for _, c := range channels {
+		r.channels[c.Name()] = c
+	}
+
+	if len(defaults) == 0 {
+		return nil, fmt.Errorf("no default channels: %w", ErrUnknownChannel)
+	}
+
+	for _, name := range defaults {
+		if _, ok := r.channels[name]; !ok {
+			return nil, fmt.Errorf("default channel %q: %w", name, ErrUnknownChannel)
+		}
+	}
+
+	for userID, recipient := range users {
+		for _, name := range recipient.Channels {
+			if _, ok := r.channels[name]; !ok {
+				return nil, fmt.Errorf("channel %q of user %s: %w", name, userID, ErrUnknownChannel)
+			}
+		}
+
+		if recipient.TimeZone != "" {
+			if _, err := storage.LoadLocation(recipient.TimeZone); err != nil {
+				return nil, fmt.Errorf("user %s: %w", userID, err)
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// Names returns names of all channels.
+func (r *Router) Names() []string {
+	names := make([]string, 0, len(r.channels))
+	for name := range r.channels {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Restrict limits delivery to the channels, e.g. when email and webhook notifications are consumed from separate
+// queues by separate senders, notifications are acked without delivery by other channels.
+func (r *Router) Restrict(names []string) error {
+	only := make(map[string]bool, len(names))
+	for _, name := range names {
+		if _, ok := r.channels[name]; !ok {
+			return fmt.Errorf("channel %q: %w", name, ErrUnknownChannel)
+		}
+
+		only[name] = true
+	}
+
+	r.only = only
+
+	return nil
+}
+
+// Delivers checks the channels are known and not excluded by Restrict, e.g. channels of copies of notifications
+// routed to the queue of the sender, so the copies are not acked without delivery.
+func (r *Router) Delivers(names []string) error {
+	for _, name := range names {
+		if _, ok := r.channels[name]; !ok || (r.only != nil && !r.only[name]) {
+			return fmt.Errorf("channel %q: %w", name, ErrUnknownChannel)
+		}
+	}
+
+	return nil
+}
+
+// RecipientOf returns preferences of the user.
+func (r *Router) RecipientOf(userID string) Recipient {
+	return r.users[userID]
+}
+
+// ChannelsOf returns channels the notification is delivered by.
+func (r *Router) ChannelsOf(notification *messagebroker.Notification) []Channel {
+	names := r.users[notification.UserID].Channels
+	if len(names) == 0 {
+		names = r.defaults
+	}
+
+	channels := make([]Channel, 0, len(names))
+	for _, name := range names {
+		if r.only == nil || r.only[name] {
+			channels = append(channels, r.channels[name])
+		}
+	}
+
+	return channels
+}
+
+// DeliveryError holds errors of channels which failed to deliver the notification.
+type DeliveryError struct {
+	Errors map[string]error
+}
+
+func (e *DeliveryError) Error() string {
+	parts := make([]string, 0, len(e.Errors))
+	for name, err := range e.Errors {
+		parts = append(parts, fmt.Sprintf("%s: %v", name, err))
+	}
+
+	return "cant deliver notification by " + strings.Join(parts, "; ")
+}
+
+// Permanent checks no channel failed by a transient error, so redelivery of the notification is useless.
+func (e *DeliveryError) Permanent() bool {
+	for _, err := range e.Errors {
+		if !errors.Is(err, notify.ErrUndeliverable) && !errors.Is(err, ErrInvalidTemplate) &&
+			!errors.Is(err, ErrUnknownChannel) {
+			return false
+		}
+	}
+
+	return true
+}
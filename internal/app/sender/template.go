@@ -0,0 +1,205 @@
+package sender
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/notify"
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+var ErrInvalidTemplate = errors.New("invalid template")
+
+// names of templates every template file defines.
+const (
+	subjectTemplate = "subject"
+	bodyTemplate    = "body"
+)
+
+// TemplateData is passed to templates, Start is in time zone of the recipient.
+type TemplateData struct {
+	EventID  string
+	Title    string
+	Start    time.Time
+	TimeZone string
+	Link     string
+	UserID   string
+	Email    string
+	Locale   string
+}
+
+type executor interface {
+	ExecuteTemplate(w io.Writer, name string, data interface{}) error
+}
+
+// template renders the subject by text/template always, the body is rendered by html/template when html is set.
+type template struct {
+	subject executor
+	body    executor
+	html    bool
+}
+
+// Templates renders messages by templates of channels and locales loaded from files <dir>/<channel>/<locale>.txt,
+// bodies of files with .html extension are rendered by html/template. Each file defines "subject" and "body"
+// templates, subjects are plain text in any file.
+type Templates struct {
+	defaultLocale string
+	// eventURL is format of links to events with %s for event id, links are empty when it is not set
+	eventURL string
+	// templates by channel and locale
+	templates map[string]map[string]*template
+}
+
+// LoadTemplates parses templates of the channels and checks they render sample data,
+// every channel must have template of the default locale.
+func LoadTemplates(dir, defaultLocale, eventURL string, channels []string) (*Templates, error) {
+	t := &Templates{
+		defaultLocale: defaultLocale,
+		eventURL:      eventURL,
+		templates:     make(map[string]map[string]*template),
+	}
+
+	for _, channel := range channels {
+		files, err := ioutil.ReadDir(filepath.Join(dir, channel))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("cant read templates of %s: %w", channel, err)
+		}
+
+		t.templates[channel] = make(map[string]*template)
+
+		for _, f := range files {
+			ext := filepath.Ext(f.Name())
+			if f.IsDir() || (ext != ".txt" && ext != ".html") {
+				continue
+			}
+
+			tmpl, err := parseTemplate(filepath.Join(dir, channel, f.Name()), ext == ".html")
+			if err != nil {
+				return nil, err
+			}
+
+			t.templates[channel][strings.TrimSuffix(f.Name(), ext)] = tmpl
+		}
+
+		if _, ok := t.templates[channel][defaultLocale]; !ok {
+			return nil, fmt.Errorf("no template of %s in default locale %s: %w", channel, defaultLocale,
+				ErrInvalidTemplate)
+		}
+	}
+
+	return t, nil
+}
+
+func parseTemplate(path string, html bool) (*template, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cant read template: %w", err)
+	}
+
+	parsed, err := texttemplate.New(filepath.Base(path)).Option("missingkey=error").Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v: %w", path, err, ErrInvalidTemplate)
+	}
+
+	if parsed.Lookup(subjectTemplate) == nil || parsed.Lookup(bodyTemplate) == nil {
+		return nil, fmt.Errorf("%s: subject or body is not defined: %w", path, ErrInvalidTemplate)
+	}
+
+	tmpl := &template{subject: parsed, body: parsed}
+
+	if html {
+		parsedHTML, err := htmltemplate.New(filepath.Base(path)).Option("missingkey=error").Parse(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v: %w", path, err, ErrInvalidTemplate)
+		}
+
+		tmpl.body = parsedHTML
+		tmpl.html = true
+	}
+
+	sample := TemplateData{
+		EventID: "event", Title: "title", Start: time.Now(), TimeZone: "UTC", Link: "https://localhost/event",
+		UserID: "user", Email: "user@localhost", Locale: "en",
+	}
+	if _, err = tmpl.render(sample); err != nil {
+		return nil, fmt.Errorf("%s: %v: %w", path, err, ErrInvalidTemplate)
+	}
+
+	return tmpl, nil
+}
+
+func (t *template) render(data TemplateData) (*notify.Message, error) {
+	var subject, body bytes.Buffer
+
+	if err := t.subject.ExecuteTemplate(&subject, subjectTemplate, data); err != nil {
+		return nil, fmt.Errorf("cant render subject: %v: %w", err, ErrInvalidTemplate)
+	}
+
+	if err := t.body.ExecuteTemplate(&body, bodyTemplate, data); err != nil {
+		return nil, fmt.Errorf("cant render body: %v: %w", err, ErrInvalidTemplate)
+	}
+
+	return &notify.Message{Subject: strings.TrimSpace(subject.String()), Body: body.String(), HTML: t.html}, nil
+}
+
+// Render renders message of the channel in locale of the recipient, falling back to language of the locale
+// and then to the default locale. Start of the event is shown in time zone of the recipient or of the event.
+func (t *Templates) Render(channel string, recipient Recipient, notification *messagebroker.Notification) (
+	*notify.Message,
+	error) {
+	templates, ok := t.templates[channel]
+	if !ok {
+		return nil, fmt.Errorf("channel %q: %w", channel, ErrUnknownChannel)
+	}
+
+	tz := recipient.TimeZone
+	if tz == "" {
+		tz = notification.TimeZone
+	}
+
+	loc, err := storage.LoadLocation(tz)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	data := TemplateData{
+		EventID:  notification.EventID,
+		Title:    notification.EventTitle,
+		Start:    notification.EventStart.In(loc),
+		TimeZone: loc.String(),
+		UserID:   notification.UserID,
+		Email:    notification.Email,
+	}
+
+	if t.eventURL != "" {
+		data.Link = fmt.Sprintf(t.eventURL, url.PathEscape(notification.EventID))
+	}
+
+	locale := recipient.Locale
+
+	candidates := []string{locale}
+	if i := strings.IndexAny(locale, "-_"); i > 0 {
+		candidates = append(candidates, locale[:i])
+	}
+
+	for _, l := range append(candidates, t.defaultLocale) {
+		if tmpl, ok := templates[l]; ok {
+			data.Locale = l
+
+			return tmpl.render(data)
+		}
+	}
+
+	return nil, fmt.Errorf("no template of %s in locale %s: %w", channel, locale, ErrInvalidTemplate)
+}
@@ -0,0 +1,72 @@
+package sender_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/app/sender"
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/notify"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTemplates(t *testing.T) {
+	t.Run("templates of the repo are valid", func(t *testing.T) {
+		_, err := sender.LoadTemplates("../../../templates", "en", "https://calendar/events/%s",
+			[]string{"email", "webhook", "file"})
+		require.NoError(t, err)
+	})
+
+	for name, files := range map[string]map[string]string{
+		"no default locale": {"email/ru.txt": `{{define "subject"}}s{{end}}{{define "body"}}b{{end}}`},
+		"syntax error":      {"email/en.txt": `{{define "subject"}}s{{end}}{{define "body"}}{{.Title}{{end}}`},
+		"no body":           {"email/en.txt": `{{define "subject"}}s{{end}}`},
+		"unknown field":     {"email/en.html": `{{define "subject"}}s{{end}}{{define "body"}}{{.Location}}{{end}}`},
+	} {
+		files := files
+
+		t.Run(name, func(t *testing.T) {
+			_, err := sender.LoadTemplates(writeTemplates(t, files), "en", "", []string{"email"})
+			require.ErrorIs(t, err, sender.ErrInvalidTemplate)
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	dir := writeTemplates(t, map[string]string{
+		"email/en.html": `{{define "subject"}}{{.Title}}{{end}}` +
+			`{{define "body"}}<a href="{{.Link}}">{{.Title}}</a> {{.Start.Format "15:04"}} {{.TimeZone}}{{end}}`,
+		"email/ru.html":    `{{define "subject"}}ru {{.Title}}{{end}}{{define "body"}}{{.Locale}}{{end}}`,
+		"email/ru-UA.html": `{{define "subject"}}ru-UA {{.Title}}{{end}}{{define "body"}}{{.Locale}}{{end}}`,
+	})
+
+	templates, err := sender.LoadTemplates(dir, "en", "https://calendar/events/%s", []string{"email"})
+	require.NoError(t, err)
+
+	n := messagebroker.NewNotification("event 1", "<b>Standup</b>", time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC),
+		"user1", "")
+	n.TimeZone = "Asia/Tokyo"
+
+	m, err := templates.Render("email", sender.Recipient{}, n)
+	require.NoError(t, err)
+	require.Equal(t, &notify.Message{
+		Subject: "<b>Standup</b>",
+		Body:    `<a href="https://calendar/events/event%201">&lt;b&gt;Standup&lt;/b&gt;</a> 19:00 Asia/Tokyo`,
+		HTML:    true,
+	}, m)
+
+	m, err = templates.Render("email", sender.Recipient{Locale: "ru-RU", TimeZone: "Europe/Moscow"}, n)
+	require.NoError(t, err)
+	require.Equal(t, "ru", m.Body, "language of the locale is used")
+
+	m, err = templates.Render("email", sender.Recipient{Locale: "ru-UA"}, n)
+	require.NoError(t, err)
+	require.Equal(t, "ru-UA", m.Body)
+
+	m, err = templates.Render("email", sender.Recipient{Locale: "de"}, n)
+	require.NoError(t, err)
+	require.Contains(t, m.Body, "Asia/Tokyo", "default locale is used")
+
+	_, err = templates.Render("sms", sender.Recipient{}, n)
+	require.ErrorIs(t, err, sender.ErrUnknownChannel)
+}
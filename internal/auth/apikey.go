@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+)
+
+// APIKeyAuthenticator authenticates callers by static API keys mapped to user ids.
+type APIKeyAuthenticator struct {
+	keys map[string]string
+}
+
+func NewAPIKeyAuthenticator(keys map[string]string) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{keys: keys}
+}
+
+func (a *APIKeyAuthenticator) Authenticate(ctx context.Context, creds Credentials) (*Principal, error) {
+	if creds.APIKey == "" {
+		return nil, ErrNoCredentials
+	}
+
+	userID := ""
+	for key, id := range a.keys {
+		if subtle.ConstantTimeCompare([]byte(key), []byte(creds.APIKey)) == 1 {
+			userID = id
+		}
+	}
+
+	if userID == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	return &Principal{UserID: userID, Method: MethodAPIKey}, nil
+}
@@ -0,0 +1,292 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+// signing algorithms supported in JWT.
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+)
+
+const jwtLeeway = 30 * time.Second
+
+// JWTAuthenticator validates bearer JWTs signed with HS256 shared secret or RS256 keys
+// and takes the user from the sub claim.
+type JWTAuthenticator struct {
+	hmacSecret []byte
+	rsaKeys    map[string]*rsa.PublicKey
+	issuer     string
+	audience   string
+	now        func() time.Time
+}
+
+// NewJWTAuthenticator creates authenticator, issuer and audience are checked only when not empty.
+// RSA keys are looked up by kid header, the only key is used for tokens without kid.
+func NewJWTAuthenticator(hmacSecret []byte, rsaKeys map[string]*rsa.PublicKey,
+	issuer, audience string) *JWTAuthenticator {
+	return &JWTAuthenticator{
+		hmacSecret: hmacSecret,
+		rsaKeys:    rsaKeys,
+		issuer:     issuer,
+		audience:   audience,
+		now:        time.Now,
+	}
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type jwtClaims struct {
+	Sub string      `json:"sub"`
+	Iss string      `json:"iss"`
+	Aud jwtAudience `json:"aud"`
+	Exp *float64    `json:"exp"`
+	Nbf *float64    `json:"nbf"`
+}
+
+// jwtAudience is either a string or an array of strings.
+type jwtAudience []string
+
+func (a *jwtAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudience{single}
+
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return fmt.Errorf("invalid aud: %w", err)
+	}
+
+	*a = multiple
+
+	return nil
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, creds Credentials) (*Principal, error) {
+	if creds.BearerToken == "" {
+		return nil, ErrNoCredentials
+	}
+
+	claims, err := a.verify(creds.BearerToken)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidCredentials)
+	}
+
+	return &Principal{UserID: claims.Sub, Method: MethodJWT}, nil
+}
+
+func (a *JWTAuthenticator) verify(token string) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("cant decode header: %w", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("cant decode signature: %w", err)
+	}
+
+	if err = a.verifySignature(header, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims jwtClaims
+	if err = decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("cant decode claims: %w", err)
+	}
+
+	if err = a.validateClaims(&claims); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+func (a *JWTAuthenticator) verifySignature(header jwtHeader, signed string, signature []byte) error {
+	switch header.Alg {
+	case AlgHS256:
+		if len(a.hmacSecret) == 0 {
+			return errors.New("HS256 is not configured")
+		}
+
+		mac := hmac.New(sha256.New, a.hmacSecret)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return errors.New("invalid signature")
+		}
+
+		return nil
+	case AlgRS256:
+		key, err := a.rsaKey(header.Kid)
+		if err != nil {
+			return err
+		}
+
+		hash := sha256.Sum256([]byte(signed))
+		if err = rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature); err != nil {
+			return fmt.Errorf("invalid signature: %w", err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unsupported alg %q", header.Alg)
+}
+
+func (a *JWTAuthenticator) rsaKey(kid string) (*rsa.PublicKey, error) {
+	if key, ok := a.rsaKeys[kid]; ok {
+		return key, nil
+	}
+
+	if kid == "" && len(a.rsaKeys) == 1 {
+		for _, key := range a.rsaKeys {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown key %q", kid)
+}
+
+func (a *JWTAuthenticator) validateClaims(claims *jwtClaims) error {
+	now := a.now()
+
+	if claims.Sub == "" {
+		return errors.New("empty sub")
+	}
+
+	// tokens without exp would never expire
+	if claims.Exp == nil {
+		return errors.New("no exp")
+	}
+
+	if now.After(unixTime(*claims.Exp).Add(jwtLeeway)) {
+		return errors.New("token is expired")
+	}
+
+	if claims.Nbf != nil && now.Add(jwtLeeway).Before(unixTime(*claims.Nbf)) {
+		return errors.New("token is not valid yet")
+	}
+
+	if a.issuer != "" && claims.Iss != a.issuer {
+		return fmt.Errorf("unexpected iss %q", claims.Iss)
+	}
+
+	if a.audience != "" {
+		for _, aud := range claims.Aud {
+			if aud == a.audience {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("unexpected aud %v", claims.Aud)
+	}
+
+	return nil
+}
+
+func unixTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// ParseRSAPublicKey parses PEM encoded PKIX or PKCS1 RSA public key.
+func ParseRSAPublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("cant parse public key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an RSA public key")
+	}
+
+	return rsaKey, nil
+}
+
+type jwks struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+// LoadJWKS reads RSA signing keys from local JWKS file, keys of other types are skipped.
+func LoadJWKS(path string) (map[string]*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cant read jwks: %w", err)
+	}
+
+	var set jwks
+	if err = json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("cant unmarshal jwks: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("cant decode modulus of key %q: %w", k.Kid, err)
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("cant decode exponent of key %q: %w", k.Kid, err)
+		}
+
+		keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+
+	return keys, nil
+}
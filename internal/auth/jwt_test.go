@@ -0,0 +1,154 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeSegment(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func hs256Token(t *testing.T, secret []byte, claims map[string]interface{}) string {
+	t.Helper()
+
+	signed := encodeSegment(t, map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed))
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func rs256Token(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	t.Helper()
+
+	signed := encodeSegment(t, map[string]string{"alg": "RS256", "kid": kid}) + "." + encodeSegment(t, claims)
+	hash := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	require.NoError(t, err)
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestJWTAuthenticatorHS256(t *testing.T) {
+	ctx := context.Background()
+	secret := []byte("secret")
+	a := auth.NewJWTAuthenticator(secret, nil, "calendar", "")
+	exp := time.Now().Add(time.Hour).Unix()
+
+	t.Run("test valid token", func(t *testing.T) {
+		token := hs256Token(t, secret, map[string]interface{}{"sub": "user1", "iss": "calendar", "exp": exp})
+
+		principal, err := a.Authenticate(ctx, auth.Credentials{BearerToken: token})
+		require.NoError(t, err)
+		require.Equal(t, &auth.Principal{UserID: "user1", Method: auth.MethodJWT}, principal)
+	})
+
+	t.Run("test invalid tokens", func(t *testing.T) {
+		for name, token := range map[string]string{
+			"other secret": hs256Token(t, []byte("other"), map[string]interface{}{"sub": "user1", "iss": "calendar"}),
+			"expired": hs256Token(t, secret, map[string]interface{}{
+				"sub": "user1", "iss": "calendar", "exp": time.Now().Add(-time.Hour).Unix(),
+			}),
+			"without exp":  hs256Token(t, secret, map[string]interface{}{"sub": "user1", "iss": "calendar"}),
+			"other issuer": hs256Token(t, secret, map[string]interface{}{"sub": "user1", "iss": "other"}),
+			"without sub":  hs256Token(t, secret, map[string]interface{}{"iss": "calendar"}),
+			"alg none": encodeSegment(t, map[string]string{"alg": "none"}) + "." +
+				encodeSegment(t, map[string]interface{}{"sub": "user1", "iss": "calendar"}) + ".",
+			"malformed": "token",
+		} {
+			_, err := a.Authenticate(ctx, auth.Credentials{BearerToken: token})
+			require.ErrorIs(t, err, auth.ErrInvalidCredentials, name)
+		}
+	})
+
+	t.Run("test no token", func(t *testing.T) {
+		_, err := a.Authenticate(ctx, auth.Credentials{APIKey: "key"})
+		require.ErrorIs(t, err, auth.ErrNoCredentials)
+	})
+}
+
+func TestJWTAuthenticatorRS256WithJWKS(t *testing.T) {
+	ctx := context.Background()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key1",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+	data, err := json.Marshal(jwks)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	keys, err := auth.LoadJWKS(path)
+	require.NoError(t, err)
+
+	a := auth.NewJWTAuthenticator(nil, keys, "", "calendar")
+	exp := time.Now().Add(time.Hour).Unix()
+
+	token := rs256Token(t, key, "key1", map[string]interface{}{
+		"sub": "user1", "aud": []string{"other", "calendar"}, "exp": exp,
+	})
+	principal, err := a.Authenticate(ctx, auth.Credentials{BearerToken: token})
+	require.NoError(t, err)
+	require.Equal(t, "user1", principal.UserID)
+
+	token = rs256Token(t, key, "key2", map[string]interface{}{"sub": "user1", "aud": "calendar", "exp": exp})
+	_, err = a.Authenticate(ctx, auth.Credentials{BearerToken: token})
+	require.ErrorIs(t, err, auth.ErrInvalidCredentials)
+
+	token = rs256Token(t, key, "key1", map[string]interface{}{"sub": "user1", "aud": "other", "exp": exp})
+	_, err = a.Authenticate(ctx, auth.Credentials{BearerToken: token})
+	require.ErrorIs(t, err, auth.ErrInvalidCredentials)
+
+	// HS256 token signed by the public key must not pass when only RSA keys are configured
+	token = hs256Token(t, key.N.Bytes(), map[string]interface{}{"sub": "user1", "aud": "calendar", "exp": exp})
+	_, err = a.Authenticate(ctx, auth.Credentials{BearerToken: token})
+	require.ErrorIs(t, err, auth.ErrInvalidCredentials)
+}
+
+func TestChain(t *testing.T) {
+	ctx := context.Background()
+	chain := auth.Chain{
+		auth.NewJWTAuthenticator([]byte("secret"), nil, "", ""),
+		auth.NewAPIKeyAuthenticator(map[string]string{"key1": "user1"}),
+	}
+
+	principal, err := chain.Authenticate(ctx, auth.Credentials{APIKey: "key1"})
+	require.NoError(t, err)
+	require.Equal(t, &auth.Principal{UserID: "user1", Method: auth.MethodAPIKey}, principal)
+
+	_, err = chain.Authenticate(ctx, auth.Credentials{APIKey: "key2"})
+	require.ErrorIs(t, err, auth.ErrInvalidCredentials)
+
+	_, err = chain.Authenticate(ctx, auth.Credentials{})
+	require.ErrorIs(t, err, auth.ErrNoCredentials)
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNoCredentials      = errors.New("no credentials")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// authentication methods.
+const (
+	MethodJWT    = "jwt"
+	MethodAPIKey = "api_key"
+)
+
+// Principal is the authenticated caller.
+type Principal struct {
+	UserID string
+	Method string
+}
+
+// Credentials are presented by the caller, empty fields are not provided.
+type Credentials struct {
+	BearerToken string
+	APIKey      string
+}
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, creds Credentials) (*Principal, error)
+}
+
+type principalKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+
+	return principal, ok && principal != nil
+}
+
+// Chain tries authenticators in order until one of them accepts or rejects credentials.
+type Chain []Authenticator
+
+func (c Chain) Authenticate(ctx context.Context, creds Credentials) (*Principal, error) {
+	for _, a := range c {
+		principal, err := a.Authenticate(ctx, creds)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+
+		return principal, err
+	}
+
+	return nil, ErrNoCredentials
+}
@@ -0,0 +1,61 @@
+package channel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/notify"
+)
+
+// File writes notifications with rendered messages as JSON lines, to stdout when path is empty or "-".
+type File struct {
+	sync.Mutex
+	w io.Writer
+}
+
+func NewFile(path string) (*File, error) {
+	if path == "" || path == "-" {
+		return &File{w: os.Stdout}, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("cant open file: %w", err)
+	}
+
+	return &File{w: f}, nil
+}
+
+func (c *File) Name() string {
+	return "file"
+}
+
+func (c *File) Send(ctx context.Context, notification *messagebroker.Notification, message *notify.Message) error {
+	data, err := json.Marshal(payload{Notification: notification, Subject: message.Subject, Body: message.Body})
+	if err != nil {
+		return fmt.Errorf("cant marshal notification: %w", err)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if _, err = c.w.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("cant write notification: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the file, stdout is left open.
+func (c *File) Close() error {
+	if f, ok := c.w.(*os.File); ok && f != os.Stdout {
+		return f.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package channel_test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/channel"
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/notify"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.log")
+
+	c, err := channel.NewFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "file", c.Name())
+
+	start := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	message := &notify.Message{Subject: "s", Body: "b"}
+	require.NoError(t, c.Send(context.Background(), messagebroker.NewNotification("event1", "a", start, "user1", ""),
+		message))
+	require.NoError(t, c.Send(context.Background(), messagebroker.NewNotification("event2", "b", start, "user1", ""),
+		message))
+	require.NoError(t, c.Close())
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, `{"Key":"","EventID":"event1","EventTitle":"a","EventStart":"2021-05-03T10:00:00Z",`+
+		`"UserID":"user1","Email":"","TimeZone":"","Subject":"s","Body":"b"}`+"\n"+
+		`{"Key":"","EventID":"event2","EventTitle":"b","EventStart":"2021-05-03T10:00:00Z",`+
+		`"UserID":"user1","Email":"","TimeZone":"","Subject":"s","Body":"b"}`+"\n", string(data))
+}
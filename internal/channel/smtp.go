@@ -0,0 +1,155 @@
+package channel
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/notify"
+)
+
+// SMTP sends notifications by email, it authenticates only when username is set. The connection is upgraded
+// by STARTTLS when the server supports it, credentials are not sent in clear text except to localhost.
+type SMTP struct {
+	addr      string
+	from      string
+	username  string
+	password  string
+	tlsConfig *tls.Config
+}
+
+// NewSMTP creates the channel, the server certificate is verified against system roots for the host of addr
+// when tlsConfig is nil.
+func NewSMTP(addr, from, username, password string, tlsConfig *tls.Config) *SMTP {
+	return &SMTP{addr: addr, from: from, username: username, password: password, tlsConfig: tlsConfig}
+}
+
+func (c *SMTP) Name() string {
+	return "email"
+}
+
+func (c *SMTP) Send(ctx context.Context, notification *messagebroker.Notification, message *notify.Message) error {
+	if notification.Email == "" {
+		return fmt.Errorf("user %s: %w", notification.UserID, notify.ErrNoAddress)
+	}
+
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return fmt.Errorf("cant dial smtp: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return fmt.Errorf("cant set deadline: %w", err)
+		}
+	}
+
+	host, _, err := net.SplitHostPort(c.addr)
+	if err != nil {
+		return fmt.Errorf("invalid smtp addr: %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return fmt.Errorf("cant create smtp client: %w", err)
+	}
+	defer client.Close()
+
+	if err = c.send(client, host, notification, message); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (c *SMTP) send(client *smtp.Client, host string, notification *messagebroker.Notification,
+	message *notify.Message) error {
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(c.tls(host)); err != nil {
+			return fmt.Errorf("cant start tls: %w", err)
+		}
+	}
+
+	if c.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.username, c.password, host)); err != nil {
+			return fmt.Errorf("cant auth: %w", err)
+		}
+	}
+
+	if err := client.Mail(c.from); err != nil {
+		return fmt.Errorf("cant set sender: %w", err)
+	}
+
+	if err := client.Rcpt(notification.Email); err != nil {
+		var protoErr *textproto.Error
+		if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+			return fmt.Errorf("recipient is rejected: %v: %w", err, notify.ErrUndeliverable)
+		}
+
+		return fmt.Errorf("cant set recipient: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("cant start data: %w", err)
+	}
+
+	if _, err = w.Write(c.message(notification, message)); err != nil {
+		return fmt.Errorf("cant write message: %w", err)
+	}
+
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("cant send message: %w", err)
+	}
+
+	return nil
+}
+
+func (c *SMTP) tls(host string) *tls.Config {
+	if c.tlsConfig == nil {
+		return &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+	}
+
+	config := c.tlsConfig.Clone()
+	if config.ServerName == "" {
+		config.ServerName = host
+	}
+
+	return config
+}
+
+func (c *SMTP) message(notification *messagebroker.Notification, message *notify.Message) []byte {
+	contentType := "text/plain"
+	if message.HTML {
+		contentType = "text/html"
+	}
+
+	headers := []string{
+		"From: " + c.from,
+		"To: " + notification.Email,
+		"Subject: " + mime.QEncoding.Encode("utf-8", oneLine(message.Subject)),
+		"Message-ID: <" + oneLine(notification.Key) + "@calendar>",
+		"MIME-Version: 1.0",
+		"Content-Type: " + contentType + "; charset=UTF-8",
+		"Content-Transfer-Encoding: 8bit",
+	}
+
+	body := strings.ReplaceAll(strings.ReplaceAll(message.Body, "\r\n", "\n"), "\n", "\r\n")
+
+	return []byte(strings.Join(headers, "\r\n") + "\r\n\r\n" + body + "\r\n")
+}
+
+// oneLine keeps header values from breaking headers.
+func oneLine(s string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(s)
+}
@@ -0,0 +1,177 @@
+package channel_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"mime"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/channel"
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/notify"
+	"github.com/stretchr/testify/require"
+)
+
+// smtpStandIn accepts one message and sends its envelope and data to the channel, it supports STARTTLS and AUTH
+// when tlsConfig is set and records them.
+func smtpStandIn(t *testing.T, rcptReply string, tlsConfig *tls.Config) (string, <-chan []string) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	received := make(chan []string, 1)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		reply := func(s string) { _, _ = conn.Write([]byte(s + "\r\n")) }
+		lines := make([]string, 0)
+
+		reply("220 localhost ESMTP")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			line = strings.TrimRight(line, "\r\n")
+			cmd := strings.ToUpper(line)
+
+			switch {
+			case strings.HasPrefix(cmd, "EHLO") && tlsConfig != nil:
+				if _, ok := conn.(*tls.Conn); ok {
+					reply("250-localhost\r\n250 AUTH PLAIN")
+				} else {
+					reply("250-localhost\r\n250 STARTTLS")
+				}
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case cmd == "STARTTLS":
+				lines = append(lines, line)
+				reply("220 ready")
+
+				conn = tls.Server(conn, tlsConfig)
+				r = bufio.NewReader(conn)
+			case strings.HasPrefix(cmd, "AUTH"):
+				if _, ok := conn.(*tls.Conn); ok {
+					lines = append(lines, line)
+				}
+				reply("235 OK")
+			case strings.HasPrefix(cmd, "MAIL FROM"):
+				lines = append(lines, line)
+				reply("250 OK")
+			case strings.HasPrefix(cmd, "RCPT TO"):
+				lines = append(lines, line)
+				reply(rcptReply)
+			case cmd == "DATA":
+				reply("354 go ahead")
+
+				for {
+					data, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+
+					data = strings.TrimRight(data, "\r\n")
+					if data == "." {
+						break
+					}
+
+					lines = append(lines, data)
+				}
+
+				reply("250 OK")
+			case cmd == "QUIT":
+				reply("221 bye")
+				received <- lines
+
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	return l.Addr().String(), received
+}
+
+func TestSMTP(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	n := messagebroker.NewNotification("event1", "Standup", time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC), "user1",
+		"user1@example.com")
+	n.Key = "event1/0/2021-05-03T10:00:00Z/user1"
+	message := &notify.Message{Subject: "Напоминание: Standup", Body: "<p>Standup</p>\n<p>link</p>", HTML: true}
+
+	t.Run("message is sent", func(t *testing.T) {
+		addr, received := smtpStandIn(t, "250 OK", nil)
+
+		c := channel.NewSMTP(addr, "calendar@example.com", "", "", nil)
+		require.Equal(t, "email", c.Name())
+		require.NoError(t, c.Send(ctx, n, message))
+
+		lines := <-received
+		require.Equal(t, "MAIL FROM:<calendar@example.com>", lines[0])
+		require.Equal(t, "RCPT TO:<user1@example.com>", lines[1])
+		require.Contains(t, lines, "Subject: "+mime.QEncoding.Encode("utf-8", "Напоминание: Standup"))
+		require.Contains(t, lines, "Content-Type: text/html; charset=UTF-8")
+		require.Equal(t, []string{"<p>Standup</p>", "<p>link</p>"}, lines[len(lines)-2:])
+	})
+
+	t.Run("credentials are sent after STARTTLS", func(t *testing.T) {
+		server := httptest.NewTLSServer(nil)
+		defer server.Close()
+
+		addr, received := smtpStandIn(t, "250 OK", server.TLS)
+
+		roots := x509.NewCertPool()
+		roots.AddCert(server.Certificate())
+		c := channel.NewSMTP(addr, "calendar@example.com", "user", "secret",
+			&tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12})
+		require.NoError(t, c.Send(ctx, n, message))
+
+		lines := <-received
+		require.Equal(t, "STARTTLS", lines[0])
+		require.Equal(t, "AUTH PLAIN AHVzZXIAc2VjcmV0", lines[1])
+		require.Equal(t, "MAIL FROM:<calendar@example.com>", lines[2])
+	})
+
+	t.Run("untrusted certificate is rejected", func(t *testing.T) {
+		server := httptest.NewTLSServer(nil)
+		defer server.Close()
+
+		addr, _ := smtpStandIn(t, "250 OK", server.TLS)
+
+		c := channel.NewSMTP(addr, "calendar@example.com", "user", "secret", nil)
+		require.Error(t, c.Send(ctx, n, message))
+	})
+
+	t.Run("rejected recipient is undeliverable", func(t *testing.T) {
+		addr, _ := smtpStandIn(t, "550 no such user", nil)
+
+		c := channel.NewSMTP(addr, "calendar@example.com", "", "", nil)
+		require.ErrorIs(t, c.Send(ctx, n, message), notify.ErrUndeliverable)
+	})
+
+	t.Run("recipient without email is skipped", func(t *testing.T) {
+		c := channel.NewSMTP("127.0.0.1:1", "calendar@example.com", "", "", nil)
+
+		owner := *n
+		owner.Email = ""
+		require.ErrorIs(t, c.Send(ctx, &owner, message), notify.ErrNoAddress)
+	})
+}
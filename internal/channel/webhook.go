@@ -0,0 +1,70 @@
+package channel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/notify"
+)
+
+// payload is the notification with its rendered message.
+type payload struct {
+	*messagebroker.Notification
+	Subject string
+	Body    string
+}
+
+// Webhook posts notifications with rendered messages as JSON to the URL.
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhook(url string, timeout time.Duration) *Webhook {
+	return &Webhook{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+func (c *Webhook) Name() string {
+	return "webhook"
+}
+
+func (c *Webhook) Send(ctx context.Context, notification *messagebroker.Notification,
+	message *notify.Message) error {
+	data, err := json.Marshal(payload{Notification: notification, Subject: message.Subject, Body: message.Body})
+	if err != nil {
+		return fmt.Errorf("cant marshal notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("cant create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", notification.Key)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("cant post: %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests:
+		return fmt.Errorf("webhook responded with status %d: %w", resp.StatusCode, notify.ErrUndeliverable)
+	default:
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+}
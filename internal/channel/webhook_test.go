@@ -0,0 +1,64 @@
+package channel_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/channel"
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/notify"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhook(t *testing.T) {
+	n := messagebroker.NewNotification("event1", "Standup", time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC), "user1", "")
+	n.Key = "key1"
+
+	t.Run("notification is posted", func(t *testing.T) {
+		var got struct {
+			messagebroker.Notification
+			Subject string
+			Body    string
+		}
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, "key1", r.Header.Get("Idempotency-Key"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		c := channel.NewWebhook(srv.URL, time.Second)
+		require.Equal(t, "webhook", c.Name())
+		require.NoError(t, c.Send(context.Background(), n, &notify.Message{Subject: "s", Body: "b"}))
+		require.Equal(t, *n, got.Notification)
+		require.Equal(t, "s", got.Subject)
+		require.Equal(t, "b", got.Body)
+	})
+
+	t.Run("error status is an error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		err := channel.NewWebhook(srv.URL, time.Second).Send(context.Background(), n, &notify.Message{})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, notify.ErrUndeliverable)
+	})
+
+	t.Run("client error status is undeliverable", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}))
+		defer srv.Close()
+
+		err := channel.NewWebhook(srv.URL, time.Second).Send(context.Background(), n, &notify.Message{})
+		require.ErrorIs(t, err, notify.ErrUndeliverable)
+	})
+}
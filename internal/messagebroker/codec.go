@@ -0,0 +1,131 @@
+package messagebroker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/seregproj/calendar/api/proto/notification"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Content types of notifications on the wire, messages without content type are legacy JSON of Notification.
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+// Schema version of the notification envelope, minor versions only add fields.
+const (
+	SchemaMajor = 1
+	SchemaMinor = 0
+)
+
+var (
+	ErrUnsupportedContentType = errors.New("unsupported content type")
+	ErrUnsupportedVersion     = errors.New("unsupported schema version")
+	ErrMalformedNotification  = errors.New("malformed notification")
+)
+
+// ValidateContentType checks the notification can be encoded in the content type.
+func ValidateContentType(contentType string) error {
+	if contentType != ContentTypeJSON && contentType != ContentTypeProtobuf {
+		return fmt.Errorf("%q: %w", contentType, ErrUnsupportedContentType)
+	}
+
+	return nil
+}
+
+// Marshal encodes the notification in the envelope of the current schema version.
+func Marshal(n *Notification, contentType string, createdAt time.Time) ([]byte, error) {
+	envelope := &notification.NotificationEnvelope{
+		SchemaMajor: SchemaMajor,
+		SchemaMinor: SchemaMinor,
+		MessageId:   n.Key,
+		CreatedAt:   timestamppb.New(createdAt),
+		Kind:        notification.NotificationEnvelope_KIND_REMINDER,
+		Payload: &notification.NotificationEnvelope_Reminder{Reminder: &notification.Reminder{
+			EventId:    n.EventID,
+			EventTitle: n.EventTitle,
+			EventStart: timestamppb.New(n.EventStart),
+			UserId:     n.UserID,
+			Email:      n.Email,
+			TimeZone:   n.TimeZone,
+		}},
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+
+	switch contentType {
+	case ContentTypeJSON:
+		data, err = protojson.Marshal(envelope)
+	case ContentTypeProtobuf:
+		data, err = proto.Marshal(envelope)
+	default:
+		return nil, fmt.Errorf("%q: %w", contentType, ErrUnsupportedContentType)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cant marshal notification: %w", err)
+	}
+
+	return data, nil
+}
+
+// Unmarshal decodes the notification by the content type, envelopes of unknown major version are rejected.
+// Unknown fields of newer minor versions are ignored.
+func Unmarshal(contentType string, data []byte) (*Notification, error) {
+	envelope := &notification.NotificationEnvelope{}
+
+	var err error
+
+	switch contentType {
+	case "":
+		return unmarshalLegacy(data)
+	case ContentTypeJSON:
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, envelope)
+	case ContentTypeProtobuf:
+		err = proto.Unmarshal(data, envelope)
+	default:
+		return nil, fmt.Errorf("%q: %w", contentType, ErrUnsupportedContentType)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrMalformedNotification)
+	}
+
+	if envelope.SchemaMajor != SchemaMajor {
+		return nil, fmt.Errorf("%d.%d: %w", envelope.SchemaMajor, envelope.SchemaMinor, ErrUnsupportedVersion)
+	}
+
+	reminder := envelope.GetReminder()
+	if envelope.Kind != notification.NotificationEnvelope_KIND_REMINDER || reminder == nil {
+		return nil, fmt.Errorf("kind %v: %w", envelope.Kind, ErrMalformedNotification)
+	}
+
+	return &Notification{
+		Key:        envelope.MessageId,
+		EventID:    reminder.EventId,
+		EventTitle: reminder.EventTitle,
+		EventStart: reminder.EventStart.AsTime(),
+		UserID:     reminder.UserId,
+		Email:      reminder.Email,
+		TimeZone:   reminder.TimeZone,
+	}, nil
+}
+
+// unmarshalLegacy decodes JSON of Notification published before the envelope.
+func unmarshalLegacy(data []byte) (*Notification, error) {
+	n := &Notification{}
+	if err := json.Unmarshal(data, n); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrMalformedNotification)
+	}
+
+	return n, nil
+}
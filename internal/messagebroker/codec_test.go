@@ -0,0 +1,70 @@
+package messagebroker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshal(t *testing.T) {
+	n := messagebroker.NewNotification("event1", "Standup", time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC), "user1",
+		"user1@example.com")
+	n.Key = "event1/15/2021-05-03T10:00:00Z/user1"
+	n.TimeZone = "Europe/Moscow"
+
+	for _, contentType := range []string{messagebroker.ContentTypeJSON, messagebroker.ContentTypeProtobuf} {
+		data, err := messagebroker.Marshal(n, contentType, time.Now())
+		require.NoError(t, err)
+
+		got, err := messagebroker.Unmarshal(contentType, data)
+		require.NoError(t, err)
+		require.Equal(t, n, got)
+	}
+
+	_, err := messagebroker.Marshal(n, "text/xml", time.Now())
+	require.ErrorIs(t, err, messagebroker.ErrUnsupportedContentType)
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Run("legacy JSON without content type", func(t *testing.T) {
+		got, err := messagebroker.Unmarshal("", []byte(`{"Key": "k", "EventID": "event1", "UserID": "user1"}`))
+		require.NoError(t, err)
+		require.Equal(t, &messagebroker.Notification{Key: "k", EventID: "event1", UserID: "user1"}, got)
+	})
+
+	t.Run("newer minor version", func(t *testing.T) {
+		got, err := messagebroker.Unmarshal(messagebroker.ContentTypeJSON, []byte(`{"schemaMajor": 1, "schemaMinor": 3,
+			"messageId": "k", "kind": "KIND_REMINDER", "reminder": {"eventId": "event1", "location": "room 1"}}`))
+		require.NoError(t, err)
+		require.Equal(t, "event1", got.EventID)
+	})
+
+	for name, c := range map[string]struct {
+		contentType string
+		data        string
+		err         error
+	}{
+		"unknown major version": {
+			messagebroker.ContentTypeJSON, `{"schemaMajor": 2, "kind": "KIND_REMINDER", "reminder": {}}`,
+			messagebroker.ErrUnsupportedVersion,
+		},
+		"missing version": {
+			messagebroker.ContentTypeJSON, `{"kind": "KIND_REMINDER", "reminder": {}}`,
+			messagebroker.ErrUnsupportedVersion,
+		},
+		"unknown kind": {
+			messagebroker.ContentTypeJSON, `{"schemaMajor": 1}`, messagebroker.ErrMalformedNotification,
+		},
+		"malformed":            {messagebroker.ContentTypeProtobuf, "{", messagebroker.ErrMalformedNotification},
+		"unknown content type": {"text/xml", "<n/>", messagebroker.ErrUnsupportedContentType},
+	} {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			_, err := messagebroker.Unmarshal(c.contentType, []byte(c.data))
+			require.ErrorIs(t, err, c.err)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package messagebroker
+
+// Delivery is a notification received from the broker, the receiver settles it by exactly one of
+// the methods of Acknowledger, unsettled deliveries are redelivered after the consumer is closed.
+type Delivery struct {
+	Notification
+	// Attempt is the number of the delivery attempt starting with 1.
+	Attempt int
+	// Delivered holds names of channels which delivered the notification in previous attempts.
+	Delivered []string
+	// Channel is the only channel the copy of the notification is delivered by, it is empty when the notification
+	// is delivered by all channels of the recipient.
+	Channel string
+	Acknowledger
+}
+
+type Acknowledger interface {
+	// Ack confirms the notification is delivered.
+	Ack() error
+	// Requeue returns the notification to the queue for immediate redelivery.
+	Requeue() error
+	// Retry redelivers the notification after backoff along with names of channels which delivered it,
+	// so they are skipped by the next attempt. Notifications out of attempts are dead-lettered.
+	Retry(delivered []string) error
+	// Reject moves the notification to the dead-letter queue.
+	Reject() error
+}
@@ -0,0 +1,342 @@
+package filebroker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+)
+
+// Directories of the spool.
+const (
+	queueDir      = "queue"
+	processingDir = "processing"
+	deadDir       = "dead"
+	tmpDir        = "tmp"
+)
+
+var ErrSettled = errors.New("delivery is already settled")
+
+// Broker spools notifications to files of the directory, so the scheduler and the sender run locally
+// as separate processes without a broker server. Files are named by the time they are due at, a consumer claims
+// a file by moving it to the processing directory. One consumer per directory is supported, files claimed
+// by a consumer which crashed are returned to the queue when it starts again.
+type Broker struct {
+	dir          string
+	retry        messagebroker.RetryPolicy
+	pollInterval time.Duration
+	seq          uint64
+}
+
+// envelope is content of the spool file.
+type envelope struct {
+	Attempt      int                        `json:"attempt"`
+	Notification messagebroker.Notification `json:"notification"`
+	Delivered    []string                   `json:"delivered,omitempty"`
+}
+
+func New(dir string, retry messagebroker.RetryPolicy, pollInterval time.Duration) (*Broker, error) {
+	if err := retry.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, sub := range []string{queueDir, processingDir, deadDir, tmpDir} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o750); err != nil {
+			return nil, fmt.Errorf("cant create spool dir: %w", err)
+		}
+	}
+
+	return &Broker{dir: dir, retry: retry, pollInterval: pollInterval}, nil
+}
+
+// PushNotification writes the notification to the queue, the file is moved to it when fully written.
+func (b *Broker) PushNotification(notification *messagebroker.Notification) error {
+	return b.enqueue(envelope{Attempt: 1, Notification: *notification}, time.Now())
+}
+
+func (b *Broker) enqueue(e envelope, dueAt time.Time) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("cant marshal notification: %w", err)
+	}
+
+	name := fmt.Sprintf("%020d-%d-%d.json", dueAt.UnixNano(), os.Getpid(), atomic.AddUint64(&b.seq, 1))
+
+	tmp, err := os.Create(filepath.Join(b.dir, tmpDir, name))
+	if err != nil {
+		return fmt.Errorf("cant create spool file: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("cant write spool file: %w", err)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("cant sync spool file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("cant close spool file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), filepath.Join(b.dir, queueDir, name)); err != nil {
+		return fmt.Errorf("cant enqueue spool file: %w", err)
+	}
+
+	return nil
+}
+
+// DeadLetters returns rejected notifications and notifications out of attempts, malformed files are skipped.
+func (b *Broker) DeadLetters() ([]messagebroker.Notification, error) {
+	names, err := b.list(deadDir)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := make([]messagebroker.Notification, 0, len(names))
+	for _, name := range names {
+		e, err := b.read(filepath.Join(b.dir, deadDir, name))
+		if err != nil {
+			continue
+		}
+
+		notifications = append(notifications, e.Notification)
+	}
+
+	return notifications, nil
+}
+
+// ConsumeNotifications polls the queue for due notifications, malformed files are moved to dead letters.
+func (b *Broker) ConsumeNotifications(ctx context.Context) (<-chan messagebroker.Delivery, error) {
+	if err := b.recover(); err != nil {
+		return nil, err
+	}
+
+	deliveries := make(chan messagebroker.Delivery)
+
+	go func() {
+		defer close(deliveries)
+
+		for {
+			if !b.consumeDue(ctx, deliveries) {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(b.pollInterval):
+			}
+		}
+	}()
+
+	return deliveries, nil
+}
+
+// recover returns files claimed before the restart to the queue.
+func (b *Broker) recover() error {
+	names, err := b.list(processingDir)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if err := os.Rename(filepath.Join(b.dir, processingDir, name), filepath.Join(b.dir, queueDir, name)); err != nil {
+			return fmt.Errorf("cant return claimed spool file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// consumeDue passes due notifications of the queue to deliveries, false is returned when ctx is done.
+func (b *Broker) consumeDue(ctx context.Context, deliveries chan<- messagebroker.Delivery) bool {
+	names, err := b.list(queueDir)
+	if err != nil {
+		fmt.Printf("cant list spool with err: %s\n", err.Error())
+
+		return true
+	}
+
+	now := time.Now().UnixNano()
+
+	for _, name := range names {
+		if dueAt(name) > now {
+			break
+		}
+
+		path := filepath.Join(b.dir, processingDir, name)
+		if err := os.Rename(filepath.Join(b.dir, queueDir, name), path); err != nil {
+			continue
+		}
+
+		e, err := b.read(path)
+		if err != nil {
+			fmt.Printf("cant read spool file with err: %s\n", err.Error())
+
+			if err := os.Rename(path, filepath.Join(b.dir, deadDir, name)); err != nil {
+				fmt.Printf("cant reject spool file with err: %s\n", err.Error())
+			}
+
+			continue
+		}
+
+		a := &acknowledger{broker: b, name: name, envelope: e}
+
+		select {
+		case <-ctx.Done():
+			_ = a.Requeue()
+
+			return false
+		case deliveries <- messagebroker.Delivery{
+			Notification: e.Notification,
+			Attempt:      e.Attempt,
+			Delivered:    e.Delivered,
+			Acknowledger: a,
+		}:
+		}
+	}
+
+	return true
+}
+
+func (b *Broker) list(sub string) ([]string, error) {
+	entries, err := ioutil.ReadDir(filepath.Join(b.dir, sub))
+	if err != nil {
+		return nil, fmt.Errorf("cant read spool dir: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".json") {
+			names = append(names, e.Name())
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func (b *Broker) read(path string) (envelope, error) {
+	var e envelope
+
+	data, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return e, fmt.Errorf("cant read spool file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &e); err != nil {
+		return e, fmt.Errorf("cant unmarshal spool file %s: %w", filepath.Base(path), err)
+	}
+
+	return e, nil
+}
+
+// dueAt parses due time of the file from its name.
+func dueAt(name string) int64 {
+	prefix := name
+	if i := strings.IndexByte(name, '-'); i >= 0 {
+		prefix = name[:i]
+	}
+
+	t, err := strconv.ParseInt(prefix, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return t
+}
+
+type acknowledger struct {
+	broker   *Broker
+	name     string
+	envelope envelope
+	settled  int32
+}
+
+func (a *acknowledger) settle() error {
+	if !atomic.CompareAndSwapInt32(&a.settled, 0, 1) {
+		return ErrSettled
+	}
+
+	return nil
+}
+
+func (a *acknowledger) path() string {
+	return filepath.Join(a.broker.dir, processingDir, a.name)
+}
+
+func (a *acknowledger) Ack() error {
+	if err := a.settle(); err != nil {
+		return err
+	}
+
+	if err := os.Remove(a.path()); err != nil {
+		return fmt.Errorf("cant ack: %w", err)
+	}
+
+	return nil
+}
+
+func (a *acknowledger) Requeue() error {
+	if err := a.settle(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(a.path(), filepath.Join(a.broker.dir, queueDir, a.name)); err != nil {
+		return fmt.Errorf("cant requeue: %w", err)
+	}
+
+	return nil
+}
+
+// Retry enqueues copy of the notification due after backoff and removes the claimed file.
+func (a *acknowledger) Retry(delivered []string) error {
+	if a.envelope.Attempt >= a.broker.retry.MaxAttempts {
+		return a.Reject()
+	}
+
+	if err := a.settle(); err != nil {
+		return err
+	}
+
+	e := envelope{Attempt: a.envelope.Attempt + 1, Notification: a.envelope.Notification, Delivered: delivered}
+	if err := a.broker.enqueue(e, time.Now().Add(a.broker.retry.Delay(a.envelope.Attempt))); err != nil {
+		_ = os.Rename(a.path(), filepath.Join(a.broker.dir, queueDir, a.name))
+
+		return fmt.Errorf("cant retry: %w", err)
+	}
+
+	if err := os.Remove(a.path()); err != nil {
+		return fmt.Errorf("cant retry: %w", err)
+	}
+
+	return nil
+}
+
+func (a *acknowledger) Reject() error {
+	if err := a.settle(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(a.path(), filepath.Join(a.broker.dir, deadDir, a.name)); err != nil {
+		return fmt.Errorf("cant reject: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package filebroker_test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	filebroker "github.com/seregproj/calendar/internal/messagebroker/file"
+	"github.com/stretchr/testify/require"
+)
+
+func receive(t *testing.T, deliveries <-chan messagebroker.Delivery) messagebroker.Delivery {
+	t.Helper()
+
+	select {
+	case d, ok := <-deliveries:
+		require.True(t, ok, "deliveries are closed")
+
+		return d
+	case <-time.After(time.Second):
+		require.FailNow(t, "no delivery")
+	}
+
+	return messagebroker.Delivery{}
+}
+
+func TestBroker(t *testing.T) {
+	dir := t.TempDir()
+	retry := messagebroker.RetryPolicy{MaxAttempts: 2, Backoff: 20 * time.Millisecond}
+
+	producer, err := filebroker.New(dir, retry, time.Millisecond)
+	require.NoError(t, err)
+
+	require.NoError(t, producer.PushNotification(&messagebroker.Notification{Key: "1"}))
+	require.NoError(t, producer.PushNotification(&messagebroker.Notification{Key: "2"}))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "queue", "00000000000000000001-1-1.json"),
+		[]byte("{"), 0o600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	consumer, err := filebroker.New(dir, retry, time.Millisecond)
+	require.NoError(t, err)
+
+	deliveries, err := consumer.ConsumeNotifications(ctx)
+	require.NoError(t, err)
+
+	d := receive(t, deliveries)
+	require.Equal(t, "1", d.Key)
+	require.NoError(t, d.Ack())
+	require.ErrorIs(t, d.Ack(), filebroker.ErrSettled)
+
+	d = receive(t, deliveries)
+	require.Equal(t, "2", d.Key)
+	require.Empty(t, d.Delivered)
+	require.NoError(t, d.Retry([]string{"email"}))
+
+	retriedAt := time.Now()
+	d = receive(t, deliveries)
+	require.Equal(t, "2", d.Key)
+	require.Equal(t, 2, d.Attempt)
+	require.Equal(t, []string{"email"}, d.Delivered)
+	require.GreaterOrEqual(t, time.Since(retriedAt), 10*time.Millisecond, "retry is delayed")
+	require.NoError(t, d.Retry(d.Delivered))
+
+	dead, err := consumer.DeadLetters()
+	require.NoError(t, err)
+	require.Equal(t, []messagebroker.Notification{{Key: "2"}}, dead)
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, "dead"))
+	require.NoError(t, err)
+	require.Len(t, files, 2, "malformed file is dead-lettered")
+}
+
+func TestBrokerRecover(t *testing.T) {
+	dir := t.TempDir()
+	retry := messagebroker.RetryPolicy{MaxAttempts: 1}
+
+	b, err := filebroker.New(dir, retry, time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, b.PushNotification(&messagebroker.Notification{Key: "1"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	deliveries, err := b.ConsumeNotifications(ctx)
+	require.NoError(t, err)
+	receive(t, deliveries)
+	cancel()
+
+	// claimed notification is returned to the queue when the consumer starts again
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	deliveries, err = b.ConsumeNotifications(ctx)
+	require.NoError(t, err)
+
+	d := receive(t, deliveries)
+	require.Equal(t, "1", d.Key)
+	require.NoError(t, d.Ack())
+}
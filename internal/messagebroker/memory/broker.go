@@ -0,0 +1,230 @@
+package memorybroker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+)
+
+var ErrSettled = errors.New("delivery is already settled")
+
+// Broker is in-process queue of notifications, it connects the scheduler and the sender running in one process,
+// e.g. in tests. Notifications which are not settled by the consumer are requeued when it stops.
+type Broker struct {
+	mu    sync.Mutex
+	retry messagebroker.RetryPolicy
+	queue []*message
+	dead  []messagebroker.Notification
+	// ready wakes up consumers waiting for notifications.
+	ready chan struct{}
+}
+
+type message struct {
+	notification messagebroker.Notification
+	attempt      int
+	delivered    []string
+}
+
+func New(retry messagebroker.RetryPolicy) (*Broker, error) {
+	if err := retry.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Broker{retry: retry, ready: make(chan struct{}, 1)}, nil
+}
+
+func (b *Broker) PushNotification(notification *messagebroker.Notification) error {
+	b.push(&message{notification: *notification, attempt: 1})
+
+	return nil
+}
+
+// Len returns the number of notifications waiting for consumers.
+func (b *Broker) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.queue)
+}
+
+// DeadLetters returns rejected notifications and notifications out of attempts.
+func (b *Broker) DeadLetters() []messagebroker.Notification {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]messagebroker.Notification{}, b.dead...)
+}
+
+func (b *Broker) push(m *message) {
+	b.mu.Lock()
+	b.queue = append(b.queue, m)
+	b.mu.Unlock()
+
+	b.wakeUp()
+}
+
+func (b *Broker) wakeUp() {
+	select {
+	case b.ready <- struct{}{}:
+	default:
+	}
+}
+
+// pop waits for the next notification, false is returned when ctx is done.
+func (b *Broker) pop(ctx context.Context) (*message, bool) {
+	for {
+		b.mu.Lock()
+		if len(b.queue) > 0 {
+			m := b.queue[0]
+			b.queue = b.queue[1:]
+			rest := len(b.queue)
+			b.mu.Unlock()
+
+			if rest > 0 {
+				b.wakeUp()
+			}
+
+			return m, true
+		}
+		b.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-b.ready:
+		}
+	}
+}
+
+func (b *Broker) ConsumeNotifications(ctx context.Context) (<-chan messagebroker.Delivery, error) {
+	deliveries := make(chan messagebroker.Delivery)
+
+	go func() {
+		defer close(deliveries)
+
+		var unsettled []*acknowledger
+		defer func() {
+			for _, a := range unsettled {
+				_ = a.Requeue()
+			}
+		}()
+
+		for {
+			m, ok := b.pop(ctx)
+			if !ok {
+				return
+			}
+
+			a := &acknowledger{broker: b, message: m}
+			unsettled = append(unsettled, a)
+
+			select {
+			case <-ctx.Done():
+				return
+			case deliveries <- messagebroker.Delivery{
+				Notification: m.notification,
+				Attempt:      m.attempt,
+				Delivered:    m.delivered,
+				Acknowledger: a,
+			}:
+			}
+
+			unsettled = pending(unsettled)
+		}
+	}()
+
+	return deliveries, nil
+}
+
+// pending filters out settled deliveries.
+func pending(acknowledgers []*acknowledger) []*acknowledger {
+	rest := acknowledgers[:0]
+	for _, a := range acknowledgers {
+		if !a.isSettled() {
+			rest = append(rest, a)
+		}
+	}
+
+	return rest
+}
+
+type acknowledger struct {
+	broker  *Broker
+	message *message
+
+	mu      sync.Mutex
+	settled bool
+}
+
+func (a *acknowledger) isSettled() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.settled
+}
+
+func (a *acknowledger) settle() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.settled {
+		return ErrSettled
+	}
+
+	a.settled = true
+
+	return nil
+}
+
+func (a *acknowledger) Ack() error {
+	return a.settle()
+}
+
+func (a *acknowledger) Requeue() error {
+	if err := a.settle(); err != nil {
+		return err
+	}
+
+	a.broker.push(a.message)
+
+	return nil
+}
+
+func (a *acknowledger) Retry(delivered []string) error {
+	if err := a.settle(); err != nil {
+		return err
+	}
+
+	if a.message.attempt >= a.broker.retry.MaxAttempts {
+		a.broker.deadLetter(a.message)
+
+		return nil
+	}
+
+	m := &message{notification: a.message.notification, attempt: a.message.attempt + 1, delivered: delivered}
+	time.AfterFunc(a.broker.retry.Delay(a.message.attempt), func() {
+		a.broker.push(m)
+	})
+
+	return nil
+}
+
+func (a *acknowledger) Reject() error {
+	if err := a.settle(); err != nil {
+		return err
+	}
+
+	a.broker.deadLetter(a.message)
+
+	return nil
+}
+
+func (b *Broker) deadLetter(m *message) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.dead = append(b.dead, m.notification)
+}
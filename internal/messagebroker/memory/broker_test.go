@@ -0,0 +1,81 @@
+package memorybroker_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	memorybroker "github.com/seregproj/calendar/internal/messagebroker/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func receive(t *testing.T, deliveries <-chan messagebroker.Delivery) messagebroker.Delivery {
+	t.Helper()
+
+	select {
+	case d, ok := <-deliveries:
+		require.True(t, ok, "deliveries are closed")
+
+		return d
+	case <-time.After(time.Second):
+		require.FailNow(t, "no delivery")
+	}
+
+	return messagebroker.Delivery{}
+}
+
+func TestBroker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b, err := memorybroker.New(messagebroker.RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond})
+	require.NoError(t, err)
+
+	deliveries, err := b.ConsumeNotifications(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, b.PushNotification(&messagebroker.Notification{Key: "1"}))
+	require.NoError(t, b.PushNotification(&messagebroker.Notification{Key: "2"}))
+
+	d := receive(t, deliveries)
+	require.Equal(t, "1", d.Key)
+	require.NoError(t, d.Ack())
+	require.ErrorIs(t, d.Ack(), memorybroker.ErrSettled)
+
+	d = receive(t, deliveries)
+	require.Equal(t, "2", d.Key)
+	require.Equal(t, 1, d.Attempt)
+	require.Empty(t, d.Delivered)
+	require.NoError(t, d.Retry([]string{"email"}))
+
+	d = receive(t, deliveries)
+	require.Equal(t, "2", d.Key)
+	require.Equal(t, 2, d.Attempt)
+	require.Equal(t, []string{"email"}, d.Delivered)
+	require.NoError(t, d.Retry(d.Delivered))
+	require.Equal(t, []messagebroker.Notification{{Key: "2"}}, b.DeadLetters())
+
+	require.NoError(t, b.PushNotification(&messagebroker.Notification{Key: "3"}))
+	d = receive(t, deliveries)
+	require.NoError(t, d.Requeue())
+
+	d = receive(t, deliveries)
+	require.Equal(t, "3", d.Key)
+	require.NoError(t, d.Reject())
+	require.Len(t, b.DeadLetters(), 2)
+
+	// unsettled notification is requeued when the consumer stops
+	require.NoError(t, b.PushNotification(&messagebroker.Notification{Key: "4"}))
+	receive(t, deliveries)
+	cancel()
+
+	_, ok := <-deliveries
+	require.False(t, ok)
+	require.Equal(t, 1, b.Len())
+}
+
+func TestBrokerInvalidRetry(t *testing.T) {
+	_, err := memorybroker.New(messagebroker.RetryPolicy{})
+	require.ErrorIs(t, err, messagebroker.ErrInvalidRetryPolicy)
+}
@@ -2,12 +2,19 @@ package messagebroker
 
 import "time"
 
+// Notification is sent to every recipient of the event, the owner and each attendee.
 type Notification struct {
+	// Key is unique for the reminder, occurrence and recipient, redelivered notifications have the same key
+	Key        string
 	EventID    string
 	EventTitle string
 	EventStart time.Time
+	UserID     string
+	Email      string
+	// TimeZone of the event, start is shown in it to recipients without time zone
+	TimeZone string
 }
 
-func NewNotification(eventID, eventTitle string, eventStart time.Time) *Notification {
-	return &Notification{EventID: eventID, EventTitle: eventTitle, EventStart: eventStart}
+func NewNotification(eventID, eventTitle string, eventStart time.Time, userID, email string) *Notification {
+	return &Notification{EventID: eventID, EventTitle: eventTitle, EventStart: eventStart, UserID: userID, Email: email}
 }
@@ -0,0 +1,235 @@
+package rbmq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+var (
+	ErrNotConnected = errors.New("not connected to rbmq")
+	ErrClosed       = errors.New("rbmq connection is closed")
+)
+
+// Channel is the part of amqp.Channel used by producers and consumers.
+type Channel interface {
+	ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp.Table) error
+	QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error
+	Qos(prefetchCount, prefetchSize int, global bool) error
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+	Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (
+		<-chan amqp.Delivery, error)
+	NotifyClose(receiver chan *amqp.Error) chan *amqp.Error
+	Confirm(noWait bool) error
+	NotifyPublish(confirm chan amqp.Confirmation) chan amqp.Confirmation
+	NotifyReturn(returns chan amqp.Return) chan amqp.Return
+	Close() error
+}
+
+// Dialer opens channel to the broker, the connection of the channel is closed along with it.
+type Dialer func(dsn string) (Channel, error)
+
+// Dial connects to RabbitMQ and opens channel on the new connection.
+func Dial(dsn string) (Channel, error) {
+	conn, err := amqp.Dial(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("cant create conn: %w", err)
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, fmt.Errorf("cant open channel: %w", err)
+	}
+
+	return &amqpChannel{Channel: ch, conn: conn}, nil
+}
+
+type amqpChannel struct {
+	*amqp.Channel
+	conn *amqp.Connection
+}
+
+func (c *amqpChannel) Close() error {
+	chErr := c.Channel.Close()
+	if err := c.conn.Close(); err != nil && !errors.Is(err, amqp.ErrClosed) {
+		return fmt.Errorf("cant close conn: %w", err)
+	}
+
+	if chErr != nil && !errors.Is(chErr, amqp.ErrClosed) {
+		return fmt.Errorf("cant close channel: %w", chErr)
+	}
+
+	return nil
+}
+
+// Backoff between reconnection attempts doubles from Min up to Max.
+type Backoff struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+var DefaultBackoff = Backoff{Min: time.Second, Max: 30 * time.Second}
+
+// Connection keeps channel to the broker open, the channel is reopened with backoff after it or its connection
+// is lost. Setup runs on every new channel, e.g. to declare topology.
+type Connection struct {
+	dsn     string
+	dial    Dialer
+	setup   func(Channel) error
+	backoff Backoff
+
+	mu     sync.RWMutex
+	ch     Channel
+	closed bool
+	// ready is closed when the channel is reopened or the connection is closed.
+	ready chan struct{}
+}
+
+func NewConnection(dsn string, dial Dialer, setup func(Channel) error, backoff Backoff) *Connection {
+	return &Connection{
+		dsn:     dsn,
+		dial:    dial,
+		setup:   setup,
+		backoff: backoff,
+		ready:   make(chan struct{}),
+	}
+}
+
+// Connect opens the channel and keeps it open till ctx is done, failure of the first attempt is returned.
+func (c *Connection) Connect(ctx context.Context) error {
+	ch, closes, err := c.open()
+	if err != nil {
+		return err
+	}
+
+	go c.keep(ctx, ch, closes)
+
+	return nil
+}
+
+// Current returns the open channel without waiting for reconnection.
+func (c *Connection) Current() (Channel, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	switch {
+	case c.closed:
+		return nil, ErrClosed
+	case c.ch == nil:
+		return nil, ErrNotConnected
+	}
+
+	return c.ch, nil
+}
+
+// Channel returns the open channel, it waits for reconnection when the channel is lost.
+func (c *Connection) Channel(ctx context.Context) (Channel, error) {
+	for {
+		c.mu.RLock()
+		ch, closed, ready := c.ch, c.closed, c.ready
+		c.mu.RUnlock()
+
+		switch {
+		case closed:
+			return nil, ErrClosed
+		case ch != nil:
+			return ch, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ready:
+		}
+	}
+}
+
+func (c *Connection) open() (Channel, chan *amqp.Error, error) {
+	ch, err := c.dial(c.dsn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closes := ch.NotifyClose(make(chan *amqp.Error, 1))
+
+	if c.setup != nil {
+		if err := c.setup(ch); err != nil {
+			_ = ch.Close()
+
+			return nil, nil, err
+		}
+	}
+
+	c.mu.Lock()
+	c.ch = ch
+	close(c.ready)
+	c.ready = make(chan struct{})
+	c.mu.Unlock()
+
+	return ch, closes, nil
+}
+
+func (c *Connection) keep(ctx context.Context, ch Channel, closes chan *amqp.Error) {
+	defer func() {
+		c.mu.Lock()
+		c.ch = nil
+		c.closed = true
+		close(c.ready)
+		c.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := ch.Close(); err != nil {
+				fmt.Printf("cant close rbmq channel with err: %s\n", err.Error())
+			}
+
+			return
+		case err := <-closes:
+			fmt.Printf("rbmq channel is lost with err: %v\n", err)
+		}
+
+		c.mu.Lock()
+		c.ch = nil
+		c.mu.Unlock()
+
+		var ok bool
+		if ch, closes, ok = c.reopen(ctx); !ok {
+			return
+		}
+	}
+}
+
+// reopen retries to open the channel with backoff till success or ctx is done.
+func (c *Connection) reopen(ctx context.Context) (Channel, chan *amqp.Error, bool) {
+	delay := c.backoff.Min
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, nil, false
+		case <-time.After(delay):
+		}
+
+		ch, closes, err := c.open()
+		if err == nil {
+			fmt.Println("rbmq channel is reopened")
+
+			return ch, closes, true
+		}
+
+		fmt.Printf("cant reopen rbmq channel with err: %s\n", err.Error())
+
+		if delay *= 2; delay > c.backoff.Max {
+			delay = c.backoff.Max
+		}
+	}
+}
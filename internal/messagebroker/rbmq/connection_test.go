@@ -0,0 +1,102 @@
+package rbmq_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker/rbmq"
+	"github.com/seregproj/calendar/internal/messagebroker/rbmq/rbmqtest"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/require"
+)
+
+var backoff = rbmq.Backoff{Min: time.Millisecond, Max: 10 * time.Millisecond}
+
+func declareQueue(ch rbmq.Channel) error {
+	_, err := ch.QueueDeclare("queue", true, false, false, false, nil)
+
+	return err
+}
+
+func TestConnection(t *testing.T) {
+	t.Run("channel is reopened and set up after restart of the broker", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		broker := rbmqtest.NewBroker()
+		conn := rbmq.NewConnection("", broker.Dial, declareQueue, backoff)
+		require.NoError(t, conn.Connect(ctx))
+
+		first, err := conn.Current()
+		require.NoError(t, err)
+
+		broker.Restart()
+
+		var second rbmq.Channel
+		require.Eventually(t, func() bool {
+			second, err = conn.Channel(ctx)
+
+			return err == nil && second != first
+		}, time.Second, time.Millisecond)
+
+		require.NoError(t, second.Publish("", "queue", false, false, amqp.Publishing{Body: []byte("a")}))
+		require.Len(t, broker.Messages("queue"), 1)
+	})
+
+	t.Run("channel is awaited while the broker is down", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		broker := rbmqtest.NewBroker()
+		conn := rbmq.NewConnection("", broker.Dial, declareQueue, backoff)
+		require.NoError(t, conn.Connect(ctx))
+
+		broker.SetDown(true)
+		require.Eventually(t, func() bool {
+			_, err := conn.Current()
+
+			return errors.Is(err, rbmq.ErrNotConnected)
+		}, time.Second, time.Millisecond)
+
+		require.Eventually(t, func() bool { return broker.Dials() > 3 }, time.Second, time.Millisecond,
+			"dials are retried")
+
+		waitCtx, waitCancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer waitCancel()
+
+		_, err := conn.Channel(waitCtx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		broker.SetDown(false)
+
+		ch, err := conn.Channel(ctx)
+		require.NoError(t, err)
+		require.NoError(t, ch.Publish("", "queue", false, false, amqp.Publishing{Body: []byte("a")}))
+	})
+
+	t.Run("connection is closed when ctx is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		broker := rbmqtest.NewBroker()
+		conn := rbmq.NewConnection("", broker.Dial, nil, backoff)
+		require.NoError(t, conn.Connect(ctx))
+
+		cancel()
+
+		require.Eventually(t, func() bool {
+			_, err := conn.Channel(context.Background())
+
+			return errors.Is(err, rbmq.ErrClosed)
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("first dial fails", func(t *testing.T) {
+		broker := rbmqtest.NewBroker()
+		broker.SetDown(true)
+
+		conn := rbmq.NewConnection("", broker.Dial, nil, backoff)
+		require.ErrorIs(t, conn.Connect(context.Background()), rbmqtest.ErrBrokerDown)
+	})
+}
@@ -0,0 +1,286 @@
+package notifications
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/messagebroker/rbmq"
+	"github.com/seregproj/calendar/internal/messagebroker/rbmq/rbmqtest"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	backoff = rbmq.Backoff{Min: time.Millisecond, Max: 10 * time.Millisecond}
+	// topology routes notifications by the default exchange to the only queue
+	topology = Topology{Durable: true, Queues: []Queue{{Name: "notifications"}}, RoutingKey: "notifications"}
+)
+
+// connect connects producer and consumer to the broker till the end of the test.
+func connect(t *testing.T, broker *rbmqtest.Broker, retry messagebroker.RetryPolicy) (*Producer, *Consumer) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	c := NewConsumer(topology, "notifications", retry, 100*time.Millisecond)
+	c.dial, c.backoff = broker.Dial, backoff
+	require.NoError(t, c.Connect(ctx, ""))
+
+	p := NewProducer(topology, messagebroker.ContentTypeProtobuf, 100*time.Millisecond)
+	p.dial, p.backoff = broker.Dial, backoff
+	require.NoError(t, p.Connect(ctx, ""))
+
+	return p, c
+}
+
+func receive(t *testing.T, deliveries <-chan messagebroker.Delivery) messagebroker.Delivery {
+	t.Helper()
+
+	select {
+	case d, ok := <-deliveries:
+		require.True(t, ok, "deliveries are closed")
+
+		return d
+	case <-time.After(time.Second):
+		require.FailNow(t, "no delivery")
+	}
+
+	return messagebroker.Delivery{}
+}
+
+func TestReconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker := rbmqtest.NewBroker()
+	p, c := connect(t, broker, messagebroker.RetryPolicy{MaxAttempts: 1})
+
+	deliveries, err := c.ConsumeNotifications(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, p.PushNotification(&messagebroker.Notification{Key: "1"}))
+	d := receive(t, deliveries)
+	require.Equal(t, "1", d.Key)
+
+	broker.SetDown(true)
+	require.Error(t, p.PushNotification(&messagebroker.Notification{Key: "2"}))
+	require.Error(t, d.Ack())
+
+	broker.SetDown(false)
+
+	// unacked notification is redelivered, consuming is resumed by the reopened channel
+	d = receive(t, deliveries)
+	require.Equal(t, "1", d.Key)
+	require.NoError(t, d.Ack())
+
+	require.Eventually(t, func() bool {
+		return p.PushNotification(&messagebroker.Notification{Key: "3"}) == nil
+	}, time.Second, time.Millisecond)
+
+	d = receive(t, deliveries)
+	require.Equal(t, "3", d.Key)
+	require.NoError(t, d.Ack())
+
+	cancel()
+
+	_, ok := <-deliveries
+	require.False(t, ok)
+}
+
+func TestRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker := rbmqtest.NewBroker()
+	p, c := connect(t, broker, messagebroker.RetryPolicy{MaxAttempts: 2, Backoff: time.Minute})
+
+	deliveries, err := c.ConsumeNotifications(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, p.PushNotification(&messagebroker.Notification{Key: "1"}))
+	d := receive(t, deliveries)
+	require.Equal(t, 1, d.Attempt)
+	require.Empty(t, d.Delivered)
+	require.NoError(t, d.Retry([]string{"email", "file"}))
+
+	require.Empty(t, broker.Messages("notifications"))
+	require.Len(t, broker.Messages("notifications.retry.1m0s"), 1)
+
+	broker.Expire("notifications.retry.1m0s")
+
+	d = receive(t, deliveries)
+	require.Equal(t, "1", d.Key)
+	require.Equal(t, 2, d.Attempt)
+	require.Equal(t, []string{"email", "file"}, d.Delivered)
+	require.NoError(t, d.Retry(d.Delivered))
+
+	dead := broker.Messages(c.DeadLetterQueue())
+	require.Len(t, dead, 1, "notification out of attempts is dead-lettered")
+	require.Equal(t, int32(2), dead[0].Headers[attemptHeader])
+
+	require.NoError(t, p.PushNotification(&messagebroker.Notification{Key: "2"}))
+	d = receive(t, deliveries)
+	require.NoError(t, d.Reject())
+	require.Len(t, broker.Messages(c.DeadLetterQueue()), 2)
+
+	ch, err := c.conn.Current()
+	require.NoError(t, err)
+	require.NoError(t, ch.Publish("", "notifications", false, false, amqp.Publishing{Body: []byte("{")}))
+	require.Eventually(t, func() bool {
+		return len(broker.Messages(c.DeadLetterQueue())) == 3
+	}, time.Second, time.Millisecond, "malformed notification is dead-lettered")
+
+	require.NoError(t, ch.Publish("", "notifications", false, false, amqp.Publishing{
+		ContentType: messagebroker.ContentTypeJSON,
+		Body:        []byte(`{"schemaMajor": 2, "messageId": "3", "kind": "KIND_REMINDER", "reminder": {}}`),
+	}))
+	require.Eventually(t, func() bool {
+		return len(broker.Messages(c.DeadLetterQueue())) == 4
+	}, time.Second, time.Millisecond, "notification of unknown major version is dead-lettered")
+}
+
+func TestUnconfirmedRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker := rbmqtest.NewBroker()
+	p, c := connect(t, broker, messagebroker.RetryPolicy{MaxAttempts: 2, Backoff: time.Minute})
+
+	deliveries, err := c.ConsumeNotifications(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, p.PushNotification(&messagebroker.Notification{Key: "1"}))
+	d := receive(t, deliveries)
+
+	broker.NackPublishes(true)
+	require.ErrorIs(t, d.Retry(nil), ErrNotConfirmed)
+	broker.NackPublishes(false)
+
+	d = receive(t, deliveries)
+	require.Equal(t, "1", d.Key, "notification is requeued when its retry is not confirmed")
+	require.Equal(t, 1, d.Attempt)
+
+	broker.HoldConfirms(true)
+	require.ErrorIs(t, d.Retry(nil), ErrConfirmTimeout)
+	broker.HoldConfirms(false)
+
+	d = receive(t, deliveries)
+	require.Equal(t, "1", d.Key)
+	require.NoError(t, d.Retry(nil))
+	require.Len(t, broker.Messages("notifications.retry.1m0s"), 2,
+		"copy of the timed out retry is stored too, delivery is at least once")
+}
+
+func TestContentTypes(t *testing.T) {
+	broker := rbmqtest.NewBroker()
+	_, c := connect(t, broker, messagebroker.RetryPolicy{MaxAttempts: 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deliveries, err := c.ConsumeNotifications(ctx)
+	require.NoError(t, err)
+
+	start := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	for _, contentType := range []string{messagebroker.ContentTypeJSON, messagebroker.ContentTypeProtobuf} {
+		p := NewProducer(topology, contentType, 100*time.Millisecond)
+		p.dial, p.backoff = broker.Dial, backoff
+		require.NoError(t, p.Connect(ctx, ""))
+
+		n := messagebroker.NewNotification("event1", "Standup", start, "user1", "user1@example.com")
+		n.Key = contentType
+		require.NoError(t, p.PushNotification(n))
+
+		d := receive(t, deliveries)
+		require.Equal(t, *n, d.Notification)
+		require.NoError(t, d.Ack())
+	}
+
+	require.ErrorIs(t, NewProducer(topology, "text/xml", time.Second).Connect(ctx, ""),
+		messagebroker.ErrUnsupportedContentType)
+}
+
+func TestConfirms(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker := rbmqtest.NewBroker()
+	p, c := connect(t, broker, messagebroker.RetryPolicy{MaxAttempts: 1})
+
+	require.NoError(t, p.PushNotification(&messagebroker.Notification{Key: "1"}))
+	require.Len(t, broker.Messages("notifications"), 1)
+
+	broker.NackPublishes(true)
+	require.ErrorIs(t, p.PushNotification(&messagebroker.Notification{Key: "2"}), ErrNotConfirmed)
+	broker.NackPublishes(false)
+
+	broker.HoldConfirms(true)
+	require.ErrorIs(t, p.PushNotification(&messagebroker.Notification{Key: "3"}), ErrConfirmTimeout)
+	broker.HoldConfirms(false)
+
+	unknown := topology
+	unknown.RoutingKey = "unknown"
+	unroutable := NewProducer(unknown, messagebroker.ContentTypeJSON, 100*time.Millisecond)
+	unroutable.dial, unroutable.backoff = broker.Dial, backoff
+	require.NoError(t, unroutable.Connect(ctx, ""))
+	require.ErrorIs(t, unroutable.PushNotification(&messagebroker.Notification{Key: "4"}), ErrUnroutable)
+
+	require.NoError(t, p.PushNotification(&messagebroker.Notification{Key: "5"}))
+	require.Len(t, broker.Messages("notifications"), 3)
+	require.Empty(t, broker.Messages(c.DeadLetterQueue()))
+}
+
+func TestTopology(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker := rbmqtest.NewBroker()
+	split := Topology{
+		Exchange:     "notifications",
+		ExchangeType: amqp.ExchangeDirect,
+		Queues: []Queue{
+			{Name: "notifications.email", Bindings: []string{"reminder.email"}},
+			{Name: "notifications.webhook", Bindings: []string{"reminder.webhook"}},
+		},
+		RoutingKey: "reminder",
+		Channels:   []string{"email", "webhook"},
+		Prefetch:   1,
+	}
+
+	p := NewProducer(split, messagebroker.ContentTypeProtobuf, 100*time.Millisecond)
+	p.dial, p.backoff = broker.Dial, backoff
+	require.NoError(t, p.Connect(ctx, ""))
+
+	c := NewConsumer(split, "notifications.email", messagebroker.RetryPolicy{MaxAttempts: 1}, 100*time.Millisecond)
+	c.dial, c.backoff = broker.Dial, backoff
+	require.NoError(t, c.Connect(ctx, ""))
+
+	require.NoError(t, p.PushNotification(&messagebroker.Notification{Key: "1"}))
+	require.NoError(t, p.PushNotification(&messagebroker.Notification{Key: "2"}))
+
+	webhook := broker.Messages("notifications.webhook")
+	require.Len(t, webhook, 2, "the queue of the channel gets the copy of every notification")
+	require.Equal(t, "reminder.webhook", webhook[0].RoutingKey)
+	require.Equal(t, "1/webhook", webhook[0].MessageId, "copies differ by ids")
+	require.Equal(t, uint8(amqp.Transient), webhook[0].DeliveryMode)
+
+	deliveries, err := c.ConsumeNotifications(ctx)
+	require.NoError(t, err)
+
+	d := receive(t, deliveries)
+	require.Equal(t, "1", d.Key)
+	require.Equal(t, "email", d.Channel, "the copy is delivered by its channel only")
+	require.Len(t, broker.Messages("notifications.email"), 1, "prefetch holds the second notification")
+	require.Equal(t, "reminder.email", broker.Messages("notifications.email")[0].RoutingKey)
+
+	require.NoError(t, d.Ack())
+	d = receive(t, deliveries)
+	require.Equal(t, "2", d.Key)
+	require.NoError(t, d.Ack())
+
+	invalid := NewConsumer(split, "notifications", messagebroker.RetryPolicy{MaxAttempts: 1}, 100*time.Millisecond)
+	require.ErrorIs(t, invalid.Connect(ctx, ""), ErrInvalidTopology)
+}
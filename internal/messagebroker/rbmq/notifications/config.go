@@ -0,0 +1,47 @@
+package notifications
+
+// TopologyConfig is the topology as it is configured for the scheduler and the sender.
+type TopologyConfig struct {
+	// Exchange notifications are published to, the default exchange routes them to queues by names when empty
+	Exchange string `yaml:"exchange" env:"RBMQ_EXCHANGE"`
+	// ExchangeType is direct, fanout or topic
+	ExchangeType string `yaml:"exchangeType" env:"RBMQ_EXCHANGE_TYPE" env-default:"direct"`
+	// Transient exchange, queues and notifications are lost on restart of the broker
+	Transient bool `yaml:"transient" env:"RBMQ_TRANSIENT"`
+	// RoutingKey of published notifications, the queue name when empty
+	RoutingKey string `yaml:"routingKey" env:"RBMQ_ROUTING_KEY"`
+	// Channels notifications are published for with routing keys <RoutingKey>.<channel>, the copy per channel
+	Channels []string `yaml:"channels" env:"RBMQ_CHANNELS"`
+	// Queues bound to the exchange, the only queue of the name when empty
+	Queues []QueueConfig `yaml:"queues"`
+}
+
+type QueueConfig struct {
+	Name     string   `yaml:"name"`
+	Bindings []string `yaml:"bindings"`
+}
+
+// Topology returns the configured topology, by default notifications are routed to the only queue of the name.
+func (c TopologyConfig) Topology(queueName string) Topology {
+	t := Topology{
+		Exchange:     c.Exchange,
+		ExchangeType: c.ExchangeType,
+		Durable:      !c.Transient,
+		RoutingKey:   c.RoutingKey,
+		Channels:     c.Channels,
+	}
+
+	for _, q := range c.Queues {
+		t.Queues = append(t.Queues, Queue{Name: q.Name, Bindings: q.Bindings})
+	}
+
+	if len(t.Queues) == 0 {
+		t.Queues = []Queue{{Name: queueName}}
+	}
+
+	if t.RoutingKey == "" {
+		t.RoutingKey = queueName
+	}
+
+	return t
+}
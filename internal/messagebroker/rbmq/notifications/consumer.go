@@ -2,85 +2,338 @@ package notifications
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/messagebroker/rbmq"
 	"github.com/streadway/amqp"
 )
 
+const (
+	// attemptHeader holds the number of the delivery attempt of redelivered messages.
+	attemptHeader = "x-attempt"
+	// deliveredHeader holds comma separated names of channels which delivered the redelivered message.
+	deliveredHeader = "x-delivered"
+	// channelHeader holds the channel the copy of the message published per channel is for.
+	channelHeader = "x-channel"
+)
+
+// Consumer consumes notifications of the queue. Retries are published to delay queues in confirm mode,
+// so the original message is acked only after the broker confirms its copy.
 type Consumer struct {
-	ch    *amqp.Channel
-	queue string
+	conn           *rbmq.Connection
+	dial           rbmq.Dialer
+	backoff        rbmq.Backoff
+	topology       Topology
+	queue          string
+	retry          messagebroker.RetryPolicy
+	confirmTimeout time.Duration
+
+	// mu serializes publishes of retries, so confirmations and returns of the channel belong to the last publish.
+	mu  sync.Mutex
+	pub *publisher
 }
 
-func NewConsumer(queue string) *Consumer {
+// NewConsumer creates consumer of the queue of the topology.
+func NewConsumer(topology Topology, queue string, retry messagebroker.RetryPolicy,
+	confirmTimeout time.Duration) *Consumer {
 	return &Consumer{
-		queue: queue,
+		dial:           rbmq.Dial,
+		backoff:        rbmq.DefaultBackoff,
+		topology:       topology,
+		queue:          queue,
+		retry:          retry,
+		confirmTimeout: confirmTimeout,
 	}
 }
 
+// DeadLetterQueue returns the queue rejected notifications and notifications out of attempts are moved to.
+func (c *Consumer) DeadLetterQueue() string {
+	return DeadLetterQueue(c.queue)
+}
+
+// retryQueue returns the delay queue the notification waits in after the failed attempt, messages expire
+// from it back to the queue. Queues are named by the delay, so changes of the policy do not conflict
+// with queues declared before.
+func (c *Consumer) retryQueue(attempt int) string {
+	return fmt.Sprintf("%s.retry.%s", c.queue, c.retry.Delay(attempt))
+}
+
+// Connect opens channel to the broker and declares the topology, the channel is reopened in background after loss
+// till ctx is done.
 func (c *Consumer) Connect(ctx context.Context, dsn string) error {
-	conn, err := amqp.Dial(dsn)
-	if err != nil {
-		return fmt.Errorf("cant create conn: %w", err)
+	if err := c.retry.Validate(); err != nil {
+		return err
 	}
 
-	c.ch, err = conn.Channel()
-	if err != nil {
-		return fmt.Errorf("cant open channel: %w", err)
+	if err := c.topology.Validate(); err != nil {
+		return err
 	}
 
-	go func() {
-		defer c.ch.Close()
+	if _, err := c.topology.Queue(c.queue); err != nil {
+		return err
+	}
 
-		<-ctx.Done()
-	}()
+	c.conn = rbmq.NewConnection(dsn, c.dial, c.declare, c.backoff)
 
-	_, err = c.ch.QueueDeclare(c.queue, true, false, false, false, nil)
-	if err != nil {
-		return fmt.Errorf("cant queue declare: %w", err)
+	return c.conn.Connect(ctx)
+}
+
+// declare declares the topology, delay queues of retries of the queue, limits unacked deliveries and puts
+// the new channel in confirm mode.
+func (c *Consumer) declare(ch rbmq.Channel) error {
+	if err := c.topology.declare(ch); err != nil {
+		return err
+	}
+
+	// retries are routed back to the queue only, not to every queue bound to the exchange
+	for attempt := 1; attempt < c.retry.MaxAttempts; attempt++ {
+		_, err := ch.QueueDeclare(c.retryQueue(attempt), c.topology.Durable, false, false, false, amqp.Table{
+			"x-message-ttl":             c.retry.Delay(attempt).Milliseconds(),
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": c.queue,
+		})
+		if err != nil {
+			return fmt.Errorf("cant declare retry queue: %w", err)
+		}
+	}
+
+	if c.topology.Prefetch > 0 {
+		if err := ch.Qos(c.topology.Prefetch, 0, false); err != nil {
+			return fmt.Errorf("cant set prefetch: %w", err)
+		}
 	}
 
+	if err := ch.Confirm(false); err != nil {
+		return fmt.Errorf("cant enable confirm mode: %w", err)
+	}
+
+	pub := &publisher{
+		ch:       ch,
+		confirms: ch.NotifyPublish(make(chan amqp.Confirmation, confirmsBuffer)),
+		returns:  ch.NotifyReturn(make(chan amqp.Return, confirmsBuffer)),
+	}
+
+	c.mu.Lock()
+	c.pub = pub
+	c.mu.Unlock()
+
 	return nil
 }
 
+// publishRetry publishes the message to the delay queue of the attempt and waits for its confirmation.
+func (c *Consumer) publishRetry(attempt int, msg amqp.Publishing) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch, err := c.conn.Current()
+	if err != nil {
+		return err
+	}
+
+	if c.pub == nil || c.pub.ch != ch {
+		return rbmq.ErrNotConnected
+	}
+
+	if err := ch.Publish("", c.retryQueue(attempt), true, false, msg); err != nil {
+		return err
+	}
+
+	c.pub.tag++
+
+	return c.pub.wait(msg.MessageId, c.confirmTimeout)
+}
+
+// ConsumeNotifications receives notifications of the queue, malformed ones and ones of unknown schema version
+// or content type are dead-lettered.
+// Consuming is resumed after the channel is reopened, the returned channel is closed when ctx is done.
 func (c *Consumer) ConsumeNotifications(ctx context.Context) (
-	<-chan messagebroker.Notification,
+	<-chan messagebroker.Delivery,
 	error) {
-	deliveries, err := c.ch.Consume(c.queue, "", false, false, false, false, nil)
+	ch, err := c.conn.Channel(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cant consume with err %w", err)
+	}
+
+	deliveries, err := ch.Consume(c.queue, "", false, false, false, false, nil)
 	if err != nil {
 		return nil, fmt.Errorf("cant consume with err %w", err)
 	}
 
-	notifications := make(chan messagebroker.Notification)
+	notifications := make(chan messagebroker.Delivery)
 	go func() {
 		defer close(notifications)
 
-		for {
-			select {
-			case <-ctx.Done():
+		for c.forward(ctx, deliveries, notifications) {
+			deliveries = c.resume(ctx)
+			if deliveries == nil {
 				return
-			case d := <-deliveries:
-				notification := messagebroker.Notification{}
-				if err := json.Unmarshal(d.Body, &notification); err != nil {
-					fmt.Printf("cant unmarshal obj from queue with err: %s\n", err.Error())
+			}
+		}
+	}()
+
+	return notifications, nil
+}
+
+// resume consumes the queue by the reopened channel, nil is returned when ctx is done or the connection is closed.
+func (c *Consumer) resume(ctx context.Context) <-chan amqp.Delivery {
+	for {
+		ch, err := c.conn.Channel(ctx)
+		if err != nil {
+			return nil
+		}
+
+		deliveries, err := ch.Consume(c.queue, "", false, false, false, false, nil)
+		if err == nil {
+			return deliveries
+		}
+
+		fmt.Printf("cant resume consuming with err: %s\n", err.Error())
 
-					continue
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(c.backoff.Min):
+		}
+	}
+}
+
+// forward passes deliveries to notifications till the deliveries are closed by loss of the channel,
+// false is returned when ctx is done.
+func (c *Consumer) forward(ctx context.Context, deliveries <-chan amqp.Delivery,
+	notifications chan<- messagebroker.Delivery) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case d, ok := <-deliveries:
+			if !ok {
+				return true
+			}
+
+			notification, err := messagebroker.Unmarshal(d.ContentType, d.Body)
+			if err != nil {
+				fmt.Printf("cant unmarshal obj from queue with err: %s\n", err.Error())
+
+				if err := d.Nack(false, false); err != nil {
+					fmt.Printf("cant reject with err: %s\n", err.Error())
 				}
 
-				select {
-				case <-ctx.Done():
-					return
-				case notifications <- notification:
-					fmt.Println("sent to channel")
-					if err := d.Ack(false); err != nil {
-						fmt.Printf("cant ack with err: %s\n", err.Error())
-					}
+				continue
+			}
+
+			attempt := attemptOf(d)
+			delivery := messagebroker.Delivery{
+				Notification: *notification,
+				Attempt:      attempt,
+				Delivered:    deliveredOf(d),
+				Channel:      channelOf(d),
+				Acknowledger: &acknowledger{consumer: c, delivery: d, attempt: attempt},
+			}
+
+			select {
+			case <-ctx.Done():
+				if err := d.Nack(false, true); err != nil {
+					fmt.Printf("cant requeue with err: %s\n", err.Error())
 				}
+
+				return false
+			case notifications <- delivery:
 			}
 		}
-	}()
+	}
+}
 
-	return notifications, nil
+func attemptOf(d amqp.Delivery) int {
+	switch v := d.Headers[attemptHeader].(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	default:
+		return 1
+	}
+}
+
+func deliveredOf(d amqp.Delivery) []string {
+	v, ok := d.Headers[deliveredHeader].(string)
+	if !ok || v == "" {
+		return nil
+	}
+
+	return strings.Split(v, ",")
+}
+
+func channelOf(d amqp.Delivery) string {
+	v, _ := d.Headers[channelHeader].(string)
+
+	return v
+}
+
+type acknowledger struct {
+	consumer *Consumer
+	delivery amqp.Delivery
+	attempt  int
+}
+
+func (a *acknowledger) Ack() error {
+	if err := a.delivery.Ack(false); err != nil {
+		return fmt.Errorf("cant ack: %w", err)
+	}
+
+	return nil
+}
+
+func (a *acknowledger) Requeue() error {
+	if err := a.delivery.Nack(false, true); err != nil {
+		return fmt.Errorf("cant requeue: %w", err)
+	}
+
+	return nil
+}
+
+// Reject nacks the message without requeue, the queue dead-letters it.
+func (a *acknowledger) Reject() error {
+	if err := a.delivery.Nack(false, false); err != nil {
+		return fmt.Errorf("cant reject: %w", err)
+	}
+
+	return nil
+}
+
+// Retry publishes copy of the message with the next attempt number and delivered channels to the delay queue
+// and acks the original after the copy is confirmed, the message is requeued when the copy is not confirmed.
+func (a *acknowledger) Retry(delivered []string) error {
+	if a.attempt >= a.consumer.retry.MaxAttempts {
+		return a.Reject()
+	}
+
+	headers := amqp.Table{}
+	for k, v := range a.delivery.Headers {
+		headers[k] = v
+	}
+
+	headers[attemptHeader] = int32(a.attempt + 1)
+	headers[deliveredHeader] = strings.Join(delivered, ",")
+
+	if err := a.consumer.publishRetry(a.attempt, amqp.Publishing{
+		Headers:      headers,
+		ContentType:  a.delivery.ContentType,
+		Type:         a.delivery.Type,
+		MessageId:    a.delivery.MessageId,
+		Timestamp:    a.delivery.Timestamp,
+		Body:         a.delivery.Body,
+		DeliveryMode: a.consumer.topology.deliveryMode(),
+	}); err != nil {
+		if nackErr := a.Requeue(); nackErr != nil {
+			return fmt.Errorf("cant publish retry: %v, %w", err, nackErr)
+		}
+
+		return fmt.Errorf("cant publish retry: %w", err)
+	}
+
+	return a.Ack()
 }
@@ -0,0 +1,30 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueues(t *testing.T) {
+	c := NewConsumer(topology, "notifications", messagebroker.RetryPolicy{MaxAttempts: 3, Backoff: 30 * time.Second},
+		time.Second)
+	require.Equal(t, "notifications.retry.1m0s", c.retryQueue(2))
+	require.Equal(t, "notifications.dead", c.DeadLetterQueue())
+}
+
+func TestAttemptOf(t *testing.T) {
+	require.Equal(t, 1, attemptOf(amqp.Delivery{}))
+	require.Equal(t, 3, attemptOf(amqp.Delivery{Headers: amqp.Table{attemptHeader: int32(3)}}))
+	require.Equal(t, 4, attemptOf(amqp.Delivery{Headers: amqp.Table{attemptHeader: int64(4)}}))
+}
+
+func TestDeliveredOf(t *testing.T) {
+	require.Empty(t, deliveredOf(amqp.Delivery{}))
+	require.Empty(t, deliveredOf(amqp.Delivery{Headers: amqp.Table{deliveredHeader: ""}}))
+	require.Equal(t, []string{"email", "file"},
+		deliveredOf(amqp.Delivery{Headers: amqp.Table{deliveredHeader: "email,file"}}))
+}
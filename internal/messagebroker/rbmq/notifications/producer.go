@@ -2,57 +2,204 @@ package notifications
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/seregproj/calendar/internal/messagebroker/rbmq"
 	"github.com/streadway/amqp"
 )
 
+var (
+	ErrUnroutable     = errors.New("notification is not routed to any queue")
+	ErrNotConfirmed   = errors.New("notification is not confirmed by broker")
+	ErrConfirmTimeout = errors.New("timeout of confirmation")
+)
+
+// Producer publishes notifications in confirm mode, publishing succeeds only after the broker acks it.
+// Notifications are published as mandatory, so ones routed to no queue are returned and reported as failed.
 type Producer struct {
-	ch         *amqp.Channel
-	routingKey string
+	conn           *rbmq.Connection
+	dial           rbmq.Dialer
+	backoff        rbmq.Backoff
+	topology       Topology
+	contentType    string
+	confirmTimeout time.Duration
+
+	// mu serializes publishes, so confirmations and returns of the channel belong to the last publish.
+	mu  sync.Mutex
+	pub *publisher
+}
+
+// confirmsBuffer holds late confirmations of publishes which timed out till the next publish skips them.
+const confirmsBuffer = 16
+
+// publisher holds confirm mode state of the channel.
+type publisher struct {
+	ch       rbmq.Channel
+	confirms chan amqp.Confirmation
+	returns  chan amqp.Return
+	// tag is delivery tag of the last publish, the broker numbers publishes of the channel from 1.
+	tag uint64
 }
 
-func NewProducer(routingKey string) *Producer {
+// NewProducer creates producer of notifications encoded in the content type, JSON or protobuf.
+func NewProducer(topology Topology, contentType string, confirmTimeout time.Duration) *Producer {
 	return &Producer{
-		routingKey: routingKey,
+		dial:           rbmq.Dial,
+		backoff:        rbmq.DefaultBackoff,
+		topology:       topology,
+		contentType:    contentType,
+		confirmTimeout: confirmTimeout,
 	}
 }
 
+// Connect opens channel to the broker, it is reopened in background after loss till ctx is done.
 func (p *Producer) Connect(ctx context.Context, dsn string) error {
-	conn, err := amqp.Dial(dsn)
-	if err != nil {
-		return fmt.Errorf("cant create conn: %w", err)
+	if err := messagebroker.ValidateContentType(p.contentType); err != nil {
+		return err
 	}
 
-	p.ch, err = conn.Channel()
-	if err != nil {
-		return fmt.Errorf("cant open channel: %w", err)
+	if err := p.topology.Validate(); err != nil {
+		return err
 	}
 
-	go func() {
-		defer p.ch.Close()
+	p.conn = rbmq.NewConnection(dsn, p.dial, p.setup, p.backoff)
 
-		<-ctx.Done()
-	}()
+	return p.conn.Connect(ctx)
+}
+
+// setup declares the topology and puts the new channel in confirm mode.
+func (p *Producer) setup(ch rbmq.Channel) error {
+	if err := p.topology.declare(ch); err != nil {
+		return err
+	}
+
+	if err := ch.Confirm(false); err != nil {
+		return fmt.Errorf("cant enable confirm mode: %w", err)
+	}
+
+	pub := &publisher{
+		ch:       ch,
+		confirms: ch.NotifyPublish(make(chan amqp.Confirmation, confirmsBuffer)),
+		returns:  ch.NotifyReturn(make(chan amqp.Return, confirmsBuffer)),
+	}
+
+	p.mu.Lock()
+	p.pub = pub
+	p.mu.Unlock()
 
 	return nil
 }
 
+// PushNotification publishes the notification by every route of the topology and waits for confirmations.
+// It fails without waiting while the channel is reopened, the notification stays in the outbox of the scheduler
+// till the next try, copies published before the failure are published again then.
 func (p *Producer) PushNotification(notification *messagebroker.Notification) error {
-	data, err := json.Marshal(notification)
+	createdAt := time.Now()
+
+	data, err := messagebroker.Marshal(notification, p.contentType, createdAt)
 	if err != nil {
 		return fmt.Errorf("cant marshal notification: %v with err: %w", notification, err)
 	}
 
-	if err = p.ch.Publish("", p.routingKey, false, false, amqp.Publishing{
-		Type:         "content/json",
-		Body:         data,
-		DeliveryMode: amqp.Persistent,
-	}); err != nil {
-		return fmt.Errorf("cant publish: %v with error: %w", data, err)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, r := range p.topology.routes() {
+		msg := amqp.Publishing{
+			ContentType:  p.contentType,
+			Type:         "reminder",
+			MessageId:    notification.Key,
+			Timestamp:    createdAt,
+			Body:         data,
+			DeliveryMode: p.topology.deliveryMode(),
+		}
+
+		// copies differ by ids, so returns of one copy are not taken for returns of another one
+		if r.channel != "" {
+			msg.Headers = amqp.Table{channelHeader: r.channel}
+			msg.MessageId = notification.Key + "/" + r.channel
+		}
+
+		if err := p.publish(r.key, msg); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+// publish publishes the message with the routing key and waits for its confirmation, p.mu is held by the caller.
+func (p *Producer) publish(key string, msg amqp.Publishing) error {
+	ch, err := p.conn.Current()
+	if err != nil {
+		return fmt.Errorf("cant publish: %w", err)
+	}
+
+	if p.pub == nil || p.pub.ch != ch {
+		return fmt.Errorf("cant publish: %w", rbmq.ErrNotConnected)
+	}
+
+	if err = ch.Publish(p.topology.Exchange, key, true, false, msg); err != nil {
+		return fmt.Errorf("cant publish: %v with error: %w", msg.Body, err)
+	}
+
+	p.pub.tag++
+
+	return p.pub.wait(msg.MessageId, p.confirmTimeout)
+}
+
+// wait waits for confirmation of the last publish, confirmations of previous publishes which timed out are
+// skipped. The broker sends return of unroutable message before its confirmation.
+func (pub *publisher) wait(key string, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			return fmt.Errorf("notification %s: %w", key, ErrConfirmTimeout)
+		case c, ok := <-pub.confirms:
+			if !ok {
+				return fmt.Errorf("notification %s: %w", key, rbmq.ErrNotConnected)
+			}
+
+			if c.DeliveryTag < pub.tag {
+				continue
+			}
+
+			switch {
+			case !c.Ack:
+				return fmt.Errorf("notification %s: %w", key, ErrNotConfirmed)
+			case pub.returned(key):
+				return fmt.Errorf("notification %s: %w", key, ErrUnroutable)
+			}
+
+			return nil
+		}
+	}
+}
+
+// returned checks returns received before confirmation for the message, returns of previous publishes
+// are skipped.
+func (pub *publisher) returned(key string) bool {
+	for {
+		select {
+		case r, ok := <-pub.returns:
+			if !ok {
+				return false
+			}
+
+			if r.MessageId == key {
+				fmt.Printf("notification %s is returned: %s\n", key, r.ReplyText)
+
+				return true
+			}
+		default:
+			return false
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package notifications
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/seregproj/calendar/internal/messagebroker/rbmq"
+	"github.com/streadway/amqp"
+)
+
+var ErrInvalidTopology = errors.New("invalid topology")
+
+// Topology describes the exchange notifications are published to and the queues bound to it, e.g. separate
+// queues of email and webhook senders. It is declared by producers and consumers on every new channel,
+// so it exists whichever of them starts first.
+type Topology struct {
+	// Exchange is the default one when empty, queues are bound to it by their names then.
+	Exchange     string
+	ExchangeType string
+	// Durable exchange and queues survive restart of the broker, notifications are persistent in them.
+	Durable bool
+	Queues  []Queue
+	// RoutingKey notifications are published with.
+	RoutingKey string
+	// Channels notifications are published for, a copy per channel is published with routing key
+	// <RoutingKey>.<channel>, e.g. reminder.email, so queues of senders of the channel are bound by it.
+	// The copy is delivered by its channel only.
+	Channels []string
+	// Prefetch limits unacked deliveries of the consumer, unlimited when zero.
+	Prefetch int
+}
+
+type Queue struct {
+	Name string
+	// Bindings are routing keys the queue is bound to the exchange with.
+	Bindings []string
+}
+
+func (t Topology) Validate() error {
+	if len(t.Queues) == 0 {
+		return fmt.Errorf("no queues: %w", ErrInvalidTopology)
+	}
+
+	if t.Prefetch < 0 {
+		return fmt.Errorf("prefetch %d: %w", t.Prefetch, ErrInvalidTopology)
+	}
+
+	if t.Exchange != "" {
+		switch t.ExchangeType {
+		case amqp.ExchangeDirect, amqp.ExchangeFanout, amqp.ExchangeTopic:
+		default:
+			return fmt.Errorf("exchange type %q: %w", t.ExchangeType, ErrInvalidTopology)
+		}
+	}
+
+	for _, c := range t.Channels {
+		switch {
+		case c == "":
+			return fmt.Errorf("channel without name: %w", ErrInvalidTopology)
+		case t.Exchange == "":
+			return fmt.Errorf("channel %s of default exchange: %w", c, ErrInvalidTopology)
+		}
+	}
+
+	for _, q := range t.Queues {
+		if q.Name == "" {
+			return fmt.Errorf("queue without name: %w", ErrInvalidTopology)
+		}
+
+		if t.Exchange == "" && len(q.Bindings) > 0 {
+			return fmt.Errorf("bindings of %s to default exchange: %w", q.Name, ErrInvalidTopology)
+		}
+	}
+
+	return nil
+}
+
+// Queue returns the queue of the topology by name.
+func (t Topology) Queue(name string) (Queue, error) {
+	for _, q := range t.Queues {
+		if q.Name == name {
+			return q, nil
+		}
+	}
+
+	return Queue{}, fmt.Errorf("unknown queue %q: %w", name, ErrInvalidTopology)
+}
+
+// route is the routing key of the copy of notifications and the channel the copy is for, the channel is empty
+// when notifications are published once for all channels.
+type route struct {
+	key     string
+	channel string
+}
+
+// routes returns routes of copies of notifications, the copy per channel or the only one.
+func (t Topology) routes() []route {
+	if len(t.Channels) == 0 {
+		return []route{{key: t.RoutingKey}}
+	}
+
+	routes := make([]route, 0, len(t.Channels))
+	for _, c := range t.Channels {
+		routes = append(routes, route{key: t.RoutingKey + "." + c, channel: c})
+	}
+
+	return routes
+}
+
+// QueueChannels returns channels copies of which are routed to the queue by its bindings, nil is returned
+// when notifications are not published per channel.
+func (t Topology) QueueChannels(name string) ([]string, error) {
+	q, err := t.Queue(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []string
+
+	for _, r := range t.routes() {
+		if r.channel == "" {
+			continue
+		}
+
+		for _, binding := range q.Bindings {
+			if rbmq.Matches(t.ExchangeType, binding, r.key) {
+				channels = append(channels, r.channel)
+
+				break
+			}
+		}
+	}
+
+	return channels, nil
+}
+
+// DeadLetterQueue returns the queue rejected notifications of the queue are moved to.
+func DeadLetterQueue(queue string) string {
+	return queue + ".dead"
+}
+
+func (t Topology) deliveryMode() uint8 {
+	if t.Durable {
+		return amqp.Persistent
+	}
+
+	return amqp.Transient
+}
+
+// declare declares the exchange, the queues along with their dead-letter queues and bindings.
+func (t Topology) declare(ch rbmq.Channel) error {
+	if t.Exchange != "" {
+		if err := ch.ExchangeDeclare(t.Exchange, t.ExchangeType, t.Durable, false, false, false, nil); err != nil {
+			return fmt.Errorf("cant exchange declare: %w", err)
+		}
+	}
+
+	for _, q := range t.Queues {
+		if _, err := ch.QueueDeclare(DeadLetterQueue(q.Name), t.Durable, false, false, false, nil); err != nil {
+			return fmt.Errorf("cant declare dead-letter queue: %w", err)
+		}
+
+		if _, err := ch.QueueDeclare(q.Name, t.Durable, false, false, false, amqp.Table{
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": DeadLetterQueue(q.Name),
+		}); err != nil {
+			return fmt.Errorf("cant queue declare: %w", err)
+		}
+
+		for _, key := range q.Bindings {
+			if err := ch.QueueBind(q.Name, key, t.Exchange, false, nil); err != nil {
+				return fmt.Errorf("cant bind queue: %v with err: %w", q.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopologyValidate(t *testing.T) {
+	require.NoError(t, topology.Validate())
+
+	tests := map[string]Topology{
+		"no queues":                    {RoutingKey: "notifications"},
+		"queue without name":           {Queues: []Queue{{}}},
+		"negative prefetch":            {Queues: []Queue{{Name: "q"}}, Prefetch: -1},
+		"unknown exchange type":        {Exchange: "e", ExchangeType: "headers", Queues: []Queue{{Name: "q"}}},
+		"bindings to default exchange": {Queues: []Queue{{Name: "q", Bindings: []string{"k"}}}},
+		"channels of default exchange": {Queues: []Queue{{Name: "q"}}, Channels: []string{"email"}},
+		"channel without name": {
+			Exchange: "e", ExchangeType: amqp.ExchangeDirect, Queues: []Queue{{Name: "q"}}, Channels: []string{""},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tc.Validate(), ErrInvalidTopology)
+		})
+	}
+
+	_, err := topology.Queue("unknown")
+	require.ErrorIs(t, err, ErrInvalidTopology)
+
+	require.NoError(t, Topology{
+		Exchange: "e", ExchangeType: amqp.ExchangeTopic, Queues: []Queue{{Name: "q", Bindings: []string{"#"}}},
+	}.Validate())
+}
+
+func TestTopologyConfig(t *testing.T) {
+	require.Equal(t, topology, TopologyConfig{}.Topology("notifications"), "the only queue of the name by default")
+
+	split := TopologyConfig{
+		Exchange:     "notifications",
+		ExchangeType: amqp.ExchangeTopic,
+		Transient:    true,
+		RoutingKey:   "reminder",
+		Channels:     []string{"email", "webhook"},
+		Queues:       []QueueConfig{{Name: "notifications.email", Bindings: []string{"reminder.email"}}},
+	}.Topology("notifications.email")
+
+	require.NoError(t, split.Validate())
+	require.False(t, split.Durable)
+	require.Equal(t, []Queue{{Name: "notifications.email", Bindings: []string{"reminder.email"}}}, split.Queues)
+	require.Equal(t, []route{{key: "reminder.email", channel: "email"}, {key: "reminder.webhook", channel: "webhook"}},
+		split.routes())
+	require.Equal(t, []route{{key: "notifications"}}, topology.routes())
+}
+
+func TestQueueChannels(t *testing.T) {
+	split := Topology{
+		Exchange:     "notifications",
+		ExchangeType: amqp.ExchangeTopic,
+		RoutingKey:   "reminder",
+		Channels:     []string{"email", "webhook", "file"},
+		Queues: []Queue{
+			{Name: "notifications.email", Bindings: []string{"reminder.email"}},
+			{Name: "notifications.rest", Bindings: []string{"reminder.webhook", "reminder.f*"}},
+			{Name: "notifications.all", Bindings: []string{"reminder.#"}},
+			{Name: "notifications.audit", Bindings: []string{"audit"}},
+		},
+	}
+
+	for queue, channels := range map[string][]string{
+		"notifications.email": {"email"},
+		"notifications.rest":  {"webhook"},
+		"notifications.all":   {"email", "webhook", "file"},
+		"notifications.audit": nil,
+	} {
+		routed, err := split.QueueChannels(queue)
+		require.NoError(t, err)
+		require.Equal(t, channels, routed, queue)
+	}
+
+	split.ExchangeType = amqp.ExchangeFanout
+	routed, err := split.QueueChannels("notifications.audit")
+	require.NoError(t, err)
+	require.Equal(t, []string{"email", "webhook", "file"}, routed)
+
+	routed, err = topology.QueueChannels("notifications")
+	require.NoError(t, err)
+	require.Nil(t, routed, "notifications are not published per channel")
+
+	_, err = split.QueueChannels("unknown")
+	require.ErrorIs(t, err, ErrInvalidTopology)
+}
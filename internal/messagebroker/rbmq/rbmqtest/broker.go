@@ -0,0 +1,582 @@
+// Package rbmqtest provides in-process stand-in of RabbitMQ for tests of producers and consumers.
+package rbmqtest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/seregproj/calendar/internal/messagebroker/rbmq"
+	"github.com/streadway/amqp"
+)
+
+var ErrBrokerDown = errors.New("broker is down")
+
+// Broker routes messages by the default exchange and by direct, fanout and topic exchanges to queues,
+// it supports acks, requeue, prefetch and dead-lettering by queue arguments. Expiration of messages is triggered
+// by Expire.
+type Broker struct {
+	mu        sync.Mutex
+	queues    map[string]*queue
+	exchanges map[string]*exchange
+	channels  []*Channel
+	down      bool
+	dials     int
+	// nack and hold change confirmations of publishes in confirm mode.
+	nack bool
+	hold bool
+}
+
+type exchange struct {
+	kind     string
+	durable  bool
+	bindings []binding
+}
+
+type binding struct {
+	key   string
+	queue string
+}
+
+type queue struct {
+	args      amqp.Table
+	messages  []amqp.Delivery
+	consumers []*consumer
+}
+
+type consumer struct {
+	ch         *Channel
+	queue      *queue
+	deliveries chan amqp.Delivery
+}
+
+func NewBroker() *Broker {
+	return &Broker{queues: make(map[string]*queue), exchanges: make(map[string]*exchange)}
+}
+
+// Dial opens channel of the broker, it fails while the broker is down.
+func (b *Broker) Dial(dsn string) (rbmq.Channel, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.dials++
+	if b.down {
+		return nil, ErrBrokerDown
+	}
+
+	ch := &Channel{broker: b, unacked: make(map[uint64]unacked)}
+	b.channels = append(b.channels, ch)
+
+	return ch, nil
+}
+
+// Dials returns the number of attempts to open channel.
+func (b *Broker) Dials() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.dials
+}
+
+// Restart closes all channels like the restarted broker does, unacked messages are returned to queues.
+func (b *Broker) Restart() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closeChannels()
+}
+
+// SetDown stops the broker, channels are closed and dials fail till the broker is up again.
+func (b *Broker) SetDown(down bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.down = down
+	if down {
+		b.closeChannels()
+	}
+}
+
+func (b *Broker) closeChannels() {
+	channels := b.channels
+	b.channels = nil
+
+	for _, ch := range channels {
+		ch.shutdown(&amqp.Error{Code: amqp.ConnectionForced, Reason: "broker is restarted", Server: true})
+	}
+}
+
+// NackPublishes makes the broker nack publishes in confirm mode as if it fails to store messages.
+func (b *Broker) NackPublishes(nack bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nack = nack
+}
+
+// HoldConfirms makes the broker withhold confirmations of publishes.
+func (b *Broker) HoldConfirms(hold bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.hold = hold
+}
+
+// Messages returns messages waiting in the queue.
+func (b *Broker) Messages(name string) []amqp.Delivery {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	q, ok := b.queues[name]
+	if !ok {
+		return nil
+	}
+
+	return append([]amqp.Delivery{}, q.messages...)
+}
+
+// Expire dead-letters messages waiting in the queue as if their TTL is over.
+func (b *Broker) Expire(name string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	q, ok := b.queues[name]
+	if !ok {
+		return
+	}
+
+	messages := q.messages
+	q.messages = nil
+
+	for _, m := range messages {
+		b.deadLetter(q, m)
+	}
+}
+
+func (b *Broker) deadLetter(q *queue, m amqp.Delivery) {
+	key, ok := q.args["x-dead-letter-routing-key"].(string)
+	if !ok {
+		return
+	}
+
+	m.Redelivered = false
+	b.route(key, m)
+}
+
+// route puts the message to the queue named by the key, false is returned for unknown queue.
+func (b *Broker) route(key string, m amqp.Delivery) bool {
+	q, ok := b.queues[key]
+	if !ok {
+		return false
+	}
+
+	m.RoutingKey = key
+	q.messages = append(q.messages, m)
+	b.dispatch(q)
+
+	return true
+}
+
+// publish puts the message to every queue bound to the exchange by the key, the default exchange routes
+// to the queue named by the key. False is returned when no queue gets the message.
+func (b *Broker) publish(e *exchange, key string, m amqp.Delivery) bool {
+	if e == nil {
+		return b.route(key, m)
+	}
+
+	routed := make(map[string]bool)
+
+	for _, bnd := range e.bindings {
+		if routed[bnd.queue] || !rbmq.Matches(e.kind, bnd.key, key) {
+			continue
+		}
+
+		q, ok := b.queues[bnd.queue]
+		if !ok {
+			continue
+		}
+
+		routed[bnd.queue] = true
+		m.RoutingKey = key
+		q.messages = append(q.messages, m)
+		b.dispatch(q)
+	}
+
+	return len(routed) > 0
+}
+
+// dispatch hands waiting messages to the first consumer of the queue while its channel is within prefetch.
+func (b *Broker) dispatch(q *queue) {
+	for len(q.consumers) > 0 && len(q.messages) > 0 {
+		c := q.consumers[0]
+		if c.ch.prefetch > 0 && len(c.ch.unacked) >= c.ch.prefetch {
+			return
+		}
+
+		m := q.messages[0]
+		q.messages = q.messages[1:]
+
+		c.ch.lastTag++
+		m.DeliveryTag = c.ch.lastTag
+		m.Acknowledger = c.ch
+		c.ch.unacked[m.DeliveryTag] = unacked{queue: q, message: m}
+		c.deliveries <- m
+	}
+}
+
+// Channel is channel of the Broker, it implements rbmq.Channel and acknowledges deliveries.
+type Channel struct {
+	broker    *Broker
+	closed    bool
+	listeners []chan *amqp.Error
+	confirms  []chan amqp.Confirmation
+	returns   []chan amqp.Return
+	confirm   bool
+	published uint64
+	consumers []*consumer
+	unacked   map[uint64]unacked
+	lastTag   uint64
+	prefetch  int
+}
+
+type unacked struct {
+	queue   *queue
+	message amqp.Delivery
+}
+
+func (ch *Channel) ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool,
+	args amqp.Table) error {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	if ch.closed {
+		return amqp.ErrClosed
+	}
+
+	if e, ok := ch.broker.exchanges[name]; ok {
+		if e.kind != kind || e.durable != durable {
+			err := &amqp.Error{Code: amqp.PreconditionFailed, Reason: fmt.Sprintf("inequivalent args of %s", name)}
+			ch.shutdown(err)
+
+			return err
+		}
+
+		return nil
+	}
+
+	switch kind {
+	case amqp.ExchangeDirect, amqp.ExchangeFanout, amqp.ExchangeTopic:
+	default:
+		err := &amqp.Error{Code: amqp.CommandInvalid, Reason: fmt.Sprintf("invalid exchange type %s", kind)}
+		ch.shutdown(err)
+
+		return err
+	}
+
+	ch.broker.exchanges[name] = &exchange{kind: kind, durable: durable}
+
+	return nil
+}
+
+func (ch *Channel) QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	if ch.closed {
+		return amqp.ErrClosed
+	}
+
+	e, ok := ch.broker.exchanges[exchange]
+	if _, found := ch.broker.queues[name]; !ok || !found {
+		err := &amqp.Error{Code: amqp.NotFound, Reason: fmt.Sprintf("no exchange %s or queue %s", exchange, name)}
+		ch.shutdown(err)
+
+		return err
+	}
+
+	for _, bnd := range e.bindings {
+		if bnd.key == key && bnd.queue == name {
+			return nil
+		}
+	}
+
+	e.bindings = append(e.bindings, binding{key: key, queue: name})
+
+	return nil
+}
+
+// Qos limits unacked deliveries of the channel by prefetchCount, the size and global are ignored.
+func (ch *Channel) Qos(prefetchCount, prefetchSize int, global bool) error {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	if ch.closed {
+		return amqp.ErrClosed
+	}
+
+	ch.prefetch = prefetchCount
+
+	return nil
+}
+
+func (ch *Channel) QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (
+	amqp.Queue, error) {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	if ch.closed {
+		return amqp.Queue{}, amqp.ErrClosed
+	}
+
+	if q, ok := ch.broker.queues[name]; ok {
+		if !reflect.DeepEqual(q.args, args) && (len(q.args) > 0 || len(args) > 0) {
+			err := &amqp.Error{Code: amqp.PreconditionFailed, Reason: fmt.Sprintf("inequivalent args of %s", name)}
+			ch.shutdown(err)
+
+			return amqp.Queue{}, err
+		}
+
+		return amqp.Queue{Name: name, Messages: len(q.messages)}, nil
+	}
+
+	ch.broker.queues[name] = &queue{args: args}
+
+	return amqp.Queue{Name: name}, nil
+}
+
+// Publish routes the message by the exchange, unroutable messages are dropped or returned when mandatory.
+// Publishes to unknown exchange close the channel. Publishes are confirmed in confirm mode.
+func (ch *Channel) Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	if ch.closed {
+		return amqp.ErrClosed
+	}
+
+	e, ok := ch.broker.exchanges[exchange]
+	if exchange != "" && !ok {
+		ch.shutdown(&amqp.Error{Code: amqp.NotFound, Reason: fmt.Sprintf("no exchange %s", exchange), Server: true})
+
+		return nil
+	}
+
+	routed := !ch.broker.nack && ch.broker.publish(e, key, amqp.Delivery{
+		Headers:      msg.Headers,
+		ContentType:  msg.ContentType,
+		DeliveryMode: msg.DeliveryMode,
+		MessageId:    msg.MessageId,
+		Timestamp:    msg.Timestamp,
+		Type:         msg.Type,
+		Exchange:     exchange,
+		Body:         msg.Body,
+	})
+
+	if !routed && mandatory && !ch.broker.nack {
+		for _, r := range ch.returns {
+			r <- amqp.Return{
+				ReplyCode: amqp.NoRoute, ReplyText: "NO_ROUTE", Exchange: exchange, RoutingKey: key,
+				MessageId: msg.MessageId, Body: msg.Body,
+			}
+		}
+	}
+
+	if ch.confirm {
+		ch.published++
+		if !ch.broker.hold {
+			for _, c := range ch.confirms {
+				c <- amqp.Confirmation{DeliveryTag: ch.published, Ack: !ch.broker.nack}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ch *Channel) Confirm(noWait bool) error {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	if ch.closed {
+		return amqp.ErrClosed
+	}
+
+	ch.confirm = true
+
+	return nil
+}
+
+func (ch *Channel) NotifyPublish(confirm chan amqp.Confirmation) chan amqp.Confirmation {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	if ch.closed {
+		close(confirm)
+	} else {
+		ch.confirms = append(ch.confirms, confirm)
+	}
+
+	return confirm
+}
+
+func (ch *Channel) NotifyReturn(returns chan amqp.Return) chan amqp.Return {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	if ch.closed {
+		close(returns)
+	} else {
+		ch.returns = append(ch.returns, returns)
+	}
+
+	return returns
+}
+
+func (ch *Channel) Consume(name, consumerTag string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (
+	<-chan amqp.Delivery, error) {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	if ch.closed {
+		return nil, amqp.ErrClosed
+	}
+
+	q, ok := ch.broker.queues[name]
+	if !ok {
+		return nil, &amqp.Error{Code: amqp.NotFound, Reason: fmt.Sprintf("no queue %s", name)}
+	}
+
+	c := &consumer{ch: ch, queue: q, deliveries: make(chan amqp.Delivery, 100)}
+	q.consumers = append(q.consumers, c)
+	ch.consumers = append(ch.consumers, c)
+	ch.broker.dispatch(q)
+
+	return c.deliveries, nil
+}
+
+func (ch *Channel) NotifyClose(receiver chan *amqp.Error) chan *amqp.Error {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	if ch.closed {
+		close(receiver)
+	} else {
+		ch.listeners = append(ch.listeners, receiver)
+	}
+
+	return receiver
+}
+
+func (ch *Channel) Close() error {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	if ch.closed {
+		return amqp.ErrClosed
+	}
+
+	ch.shutdown(nil)
+
+	return nil
+}
+
+// shutdown closes the channel, listeners get err unless the channel is closed gracefully.
+func (ch *Channel) shutdown(err *amqp.Error) {
+	if ch.closed {
+		return
+	}
+
+	ch.closed = true
+
+	for _, l := range ch.listeners {
+		if err != nil {
+			l <- err
+		}
+
+		close(l)
+	}
+
+	for _, c := range ch.confirms {
+		close(c)
+	}
+
+	for _, r := range ch.returns {
+		close(r)
+	}
+
+	for _, c := range ch.consumers {
+		consumers := c.queue.consumers[:0]
+		for _, other := range c.queue.consumers {
+			if other != c {
+				consumers = append(consumers, other)
+			}
+		}
+
+		c.queue.consumers = consumers
+		close(c.deliveries)
+	}
+
+	unacked := ch.unacked
+	ch.unacked = nil
+
+	for _, u := range unacked {
+		u.message.Redelivered = true
+		u.queue.messages = append([]amqp.Delivery{u.message}, u.queue.messages...)
+		ch.broker.dispatch(u.queue)
+	}
+}
+
+func (ch *Channel) Ack(tag uint64, multiple bool) error {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	u, err := ch.settle(tag)
+	if err != nil {
+		return err
+	}
+
+	ch.broker.dispatch(u.queue)
+
+	return nil
+}
+
+func (ch *Channel) Nack(tag uint64, multiple bool, requeue bool) error {
+	ch.broker.mu.Lock()
+	defer ch.broker.mu.Unlock()
+
+	u, err := ch.settle(tag)
+	if err != nil {
+		return err
+	}
+
+	if requeue {
+		u.message.Redelivered = true
+		u.queue.messages = append([]amqp.Delivery{u.message}, u.queue.messages...)
+		ch.broker.dispatch(u.queue)
+	} else {
+		ch.broker.deadLetter(u.queue, u.message)
+		ch.broker.dispatch(u.queue)
+	}
+
+	return nil
+}
+
+func (ch *Channel) Reject(tag uint64, requeue bool) error {
+	return ch.Nack(tag, false, requeue)
+}
+
+func (ch *Channel) settle(tag uint64) (unacked, error) {
+	if ch.closed {
+		return unacked{}, amqp.ErrClosed
+	}
+
+	u, ok := ch.unacked[tag]
+	if !ok {
+		return unacked{}, &amqp.Error{Code: amqp.PreconditionFailed, Reason: fmt.Sprintf("unknown delivery tag %d", tag)}
+	}
+
+	delete(ch.unacked, tag)
+
+	return u, nil
+}
@@ -0,0 +1,41 @@
+package rbmq
+
+import (
+	"strings"
+
+	"github.com/streadway/amqp"
+)
+
+// Matches reports whether the routing key matches the binding key of the exchange of the kind.
+func Matches(kind, binding, key string) bool {
+	switch kind {
+	case amqp.ExchangeFanout:
+		return true
+	case amqp.ExchangeTopic:
+		return matchTopic(strings.Split(binding, "."), strings.Split(key, "."))
+	default:
+		return binding == key
+	}
+}
+
+// matchTopic matches words of the key to words of the pattern, * stands for one word and # for zero or more.
+func matchTopic(pattern, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(words); i++ {
+			if matchTopic(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+
+		return false
+	case "*":
+		return len(words) > 0 && matchTopic(pattern[1:], words[1:])
+	default:
+		return len(words) > 0 && pattern[0] == words[0] && matchTopic(pattern[1:], words[1:])
+	}
+}
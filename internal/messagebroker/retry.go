@@ -0,0 +1,43 @@
+package messagebroker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidRetryPolicy = errors.New("invalid retry policy")
+
+// RetryPolicy delays redelivery of failed notifications exponentially from Backoff up to MaxBackoff,
+// notifications are dead-lettered after MaxAttempts attempts.
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Delay returns backoff after the failed attempt, attempts are counted from 1.
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	d := p.Backoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	return d
+}
+
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 1 {
+		return fmt.Errorf("max attempts %d: %w", p.MaxAttempts, ErrInvalidRetryPolicy)
+	}
+
+	if p.MaxAttempts > 1 && p.Backoff < time.Millisecond {
+		return fmt.Errorf("backoff %v: %w", p.Backoff, ErrInvalidRetryPolicy)
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package messagebroker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/messagebroker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	p := messagebroker.RetryPolicy{MaxAttempts: 6, Backoff: 30 * time.Second, MaxBackoff: 3 * time.Minute}
+
+	require.Equal(t, 30*time.Second, p.Delay(1))
+	require.Equal(t, time.Minute, p.Delay(2))
+	require.Equal(t, 2*time.Minute, p.Delay(3))
+	require.Equal(t, 3*time.Minute, p.Delay(4))
+	require.Equal(t, 3*time.Minute, p.Delay(5))
+
+	require.NoError(t, p.Validate())
+	require.NoError(t, messagebroker.RetryPolicy{MaxAttempts: 1}.Validate())
+	require.ErrorIs(t, messagebroker.RetryPolicy{}.Validate(), messagebroker.ErrInvalidRetryPolicy)
+	require.ErrorIs(t, messagebroker.RetryPolicy{MaxAttempts: 3}.Validate(), messagebroker.ErrInvalidRetryPolicy)
+}
@@ -0,0 +1,19 @@
+// Package notify holds types shared by the sender and channels delivering its notifications.
+package notify
+
+import "errors"
+
+var (
+	// ErrNoAddress is returned by channels which have no address of the recipient, such channels are skipped.
+	ErrNoAddress = errors.New("no address of recipient")
+	// ErrUndeliverable is returned by channels for failures retries do not fix, e.g. rejected recipient.
+	ErrUndeliverable = errors.New("undeliverable notification")
+)
+
+// Message is a notification rendered for the channel.
+type Message struct {
+	Subject string
+	Body    string
+	// HTML is set when Body is rendered by html/template
+	HTML bool
+}
@@ -0,0 +1,101 @@
+package internalgrpc
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/seregproj/calendar/api/proto"
+	"github.com/seregproj/calendar/internal/app/calendar"
+	"github.com/seregproj/calendar/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var attendeeRoles = map[pb.AttendeeRole]storage.AttendeeRole{
+	pb.AttendeeRole_ATTENDEE_ROLE_REQUIRED: storage.RoleRequired,
+	pb.AttendeeRole_ATTENDEE_ROLE_OPTIONAL: storage.RoleOptional,
+	pb.AttendeeRole_ATTENDEE_ROLE_CHAIR:    storage.RoleChair,
+}
+
+var rsvpStatuses = map[pb.RsvpStatus]storage.RSVPStatus{
+	pb.RsvpStatus_RSVP_STATUS_NEEDS_ACTION: storage.StatusNeedsAction,
+	pb.RsvpStatus_RSVP_STATUS_ACCEPTED:     storage.StatusAccepted,
+	pb.RsvpStatus_RSVP_STATUS_DECLINED:     storage.StatusDeclined,
+	pb.RsvpStatus_RSVP_STATUS_TENTATIVE:    storage.StatusTentative,
+}
+
+func fromAppAttendee(a *storage.Attendee) *pb.Attendee {
+	pba := pb.Attendee{UserId: a.UserID, Email: a.Email}
+
+	for k, v := range attendeeRoles {
+		if v == a.Role {
+			pba.Role = k
+		}
+	}
+
+	for k, v := range rsvpStatuses {
+		if v == a.Status {
+			pba.Status = k
+		}
+	}
+
+	return &pba
+}
+
+func attendeeError(err error) error {
+	switch {
+	case errors.Is(err, calendar.ErrEventNotFound):
+		return status.Errorf(codes.NotFound, calendar.ErrEventNotFound.Error())
+	case errors.Is(err, calendar.ErrAttendeeNotFound):
+		return status.Errorf(codes.NotFound, calendar.ErrAttendeeNotFound.Error())
+	case errors.Is(err, calendar.ErrInvalidAttendee):
+		return status.Errorf(codes.InvalidArgument, calendar.ErrInvalidAttendee.Error())
+	}
+
+	return status.Errorf(codes.Internal, calendar.ErrUnexpected.Error())
+}
+
+func (s EventServer) InviteAttendee(ctx context.Context, req *pb.InviteAttendeeRequest) (
+	*pb.InviteAttendeeResponse,
+	error) {
+	role, ok := attendeeRoles[req.GetAttendee().GetRole()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role: %v", req.GetAttendee().GetRole())
+	}
+
+	attendee, err := storage.NewAttendee(req.GetAttendee().GetUserId(), req.GetAttendee().GetEmail(), role)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err = s.app.InviteAttendee(ctx, req.GetUuid(), attendee); err != nil {
+		return nil, attendeeError(err)
+	}
+
+	return &pb.InviteAttendeeResponse{}, nil
+}
+
+func (s EventServer) RemoveAttendee(ctx context.Context, req *pb.RemoveAttendeeRequest) (
+	*pb.RemoveAttendeeResponse,
+	error) {
+	if err := s.app.RemoveAttendee(ctx, req.GetUuid(), req.GetUserId()); err != nil {
+		return nil, attendeeError(err)
+	}
+
+	return &pb.RemoveAttendeeResponse{}, nil
+}
+
+func (s EventServer) RespondToInvitation(ctx context.Context, req *pb.RespondToInvitationRequest) (
+	*pb.RespondToInvitationResponse,
+	error) {
+	rsvp, ok := rsvpStatuses[req.GetStatus()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status: %v", req.GetStatus())
+	}
+
+	if err := s.app.RespondToInvitation(ctx, req.GetUuid(), rsvp); err != nil {
+		return nil, attendeeError(err)
+	}
+
+	return &pb.RespondToInvitationResponse{}, nil
+}
@@ -0,0 +1,68 @@
+package internalgrpc
+
+import (
+	"context"
+	"errors"
+	"net/textproto"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/seregproj/calendar/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadata keys (HTTP headers for the gateway) with credentials.
+const (
+	AuthorizationMetadataKey = "authorization"
+	APIKeyMetadataKey        = "x-api-key"
+)
+
+const bearerPrefix = "bearer "
+
+// AuthUnaryInterceptor authenticates every call and puts the principal in context of the handler.
+func AuthUnaryInterceptor(authenticator auth.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{},
+		error) {
+		principal, err := authenticator.Authenticate(ctx, credentialsFromMetadata(ctx))
+		if err != nil {
+			if errors.Is(err, auth.ErrNoCredentials) {
+				return nil, status.Errorf(codes.Unauthenticated, auth.ErrNoCredentials.Error())
+			}
+
+			return nil, status.Errorf(codes.Unauthenticated, auth.ErrInvalidCredentials.Error())
+		}
+
+		return handler(auth.ContextWithPrincipal(ctx, principal), req)
+	}
+}
+
+func credentialsFromMetadata(ctx context.Context) auth.Credentials {
+	md, _ := metadata.FromIncomingContext(ctx)
+	creds := auth.Credentials{}
+
+	if values := md.Get(AuthorizationMetadataKey); len(values) > 0 {
+		if strings.HasPrefix(strings.ToLower(values[0]), bearerPrefix) {
+			creds.BearerToken = strings.TrimSpace(values[0][len(bearerPrefix):])
+		}
+	}
+
+	if values := md.Get(APIKeyMetadataKey); len(values) > 0 {
+		creds.APIKey = values[0]
+	}
+
+	return creds
+}
+
+// IncomingHeaderMatcher forwards the API key header of HTTP requests to metadata, the gateway forwards
+// Authorization header by itself.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == textproto.CanonicalMIMEHeaderKey(APIKeyMetadataKey) {
+		return APIKeyMetadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
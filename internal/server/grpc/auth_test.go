@@ -0,0 +1,103 @@
+package internalgrpc_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/seregproj/calendar/internal/auth"
+	internalgrpc "github.com/seregproj/calendar/internal/server/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// credentialsAuthenticator accepts the key and records presented credentials.
+type credentialsAuthenticator struct {
+	presented auth.Credentials
+}
+
+func (a *credentialsAuthenticator) Authenticate(ctx context.Context, creds auth.Credentials) (*auth.Principal, error) {
+	a.presented = creds
+
+	switch {
+	case creds.APIKey == "" && creds.BearerToken == "":
+		return nil, auth.ErrNoCredentials
+	case creds.APIKey == "key1" || creds.BearerToken == "token1":
+		return &auth.Principal{UserID: "user1", Method: auth.MethodAPIKey}, nil
+	default:
+		return nil, auth.ErrInvalidCredentials
+	}
+}
+
+// call runs the interceptor with the incoming metadata and returns the principal seen by the handler.
+func call(authenticator auth.Authenticator, md metadata.MD) (*auth.Principal, error) {
+	var principal *auth.Principal
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		principal, _ = auth.PrincipalFromContext(ctx)
+
+		return "ok", nil
+	}
+
+	_, err := internalgrpc.AuthUnaryInterceptor(authenticator)(metadata.NewIncomingContext(context.Background(), md),
+		nil, &grpc.UnaryServerInfo{FullMethod: "/event.EventService/GetEvent"}, handler)
+
+	return principal, err
+}
+
+func TestAuthUnaryInterceptor(t *testing.T) {
+	a := &credentialsAuthenticator{}
+
+	t.Run("test call without credentials is rejected", func(t *testing.T) {
+		_, err := call(a, metadata.MD{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("test invalid credentials are rejected", func(t *testing.T) {
+		_, err := call(a, metadata.Pairs(internalgrpc.APIKeyMetadataKey, "key2"))
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = call(a, metadata.Pairs(internalgrpc.AuthorizationMetadataKey, "Basic dXNlcjE6cHdk"))
+		require.Equal(t, codes.Unauthenticated, status.Code(err), "only bearer tokens are taken")
+		require.Equal(t, auth.Credentials{}, a.presented)
+	})
+
+	t.Run("test valid credentials are accepted", func(t *testing.T) {
+		principal, err := call(a, metadata.Pairs(internalgrpc.APIKeyMetadataKey, "key1"))
+		require.NoError(t, err)
+		require.Equal(t, "user1", principal.UserID)
+
+		principal, err = call(a, metadata.Pairs(internalgrpc.AuthorizationMetadataKey, "Bearer token1"))
+		require.NoError(t, err)
+		require.Equal(t, "user1", principal.UserID)
+		require.Equal(t, auth.Credentials{BearerToken: "token1"}, a.presented)
+	})
+}
+
+func TestIncomingHeaderMatcher(t *testing.T) {
+	a := &credentialsAuthenticator{}
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(internalgrpc.IncomingHeaderMatcher))
+
+	req := httptest.NewRequest("GET", "/v1/events/1", nil)
+	req.Header.Set("Authorization", "Bearer token1")
+	req.Header.Set("X-Api-Key", "key1")
+	req.Header.Set("X-Other", "value")
+
+	ctx, err := runtime.AnnotateContext(context.Background(), mux, req, "/event.EventService/GetEvent")
+	require.NoError(t, err)
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, []string{"Bearer token1"}, md.Get(internalgrpc.AuthorizationMetadataKey))
+	require.Equal(t, []string{"key1"}, md.Get(internalgrpc.APIKeyMetadataKey))
+	require.Empty(t, md.Get("x-other"), "other headers are not forwarded")
+
+	principal, err := call(a, md)
+	require.NoError(t, err)
+	require.Equal(t, "user1", principal.UserID)
+	require.Equal(t, auth.Credentials{BearerToken: "token1", APIKey: "key1"}, a.presented)
+}
@@ -3,10 +3,12 @@ package internalgrpc
 import (
 	"context"
 	"errors"
+	"time"
 
 	pb "github.com/seregproj/calendar/api/proto"
 	"github.com/seregproj/calendar/internal/app/calendar"
 	"github.com/seregproj/calendar/internal/storage"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -23,22 +25,114 @@ func NewEventServer(app Application) *EventServer {
 
 type Application interface {
 	CreateEvent(ctx context.Context, event *storage.Event) (string, error)
+	GetEvent(ctx context.Context, uuid string) (*storage.Event, error)
 	UpdateEvent(ctx context.Context, uuid string, event *storage.Event) error
 	DeleteEvent(ctx context.Context, uuid string) error
-	GetEventsByDay(ctx context.Context, date string, limit, offset int64) ([]*storage.Event, error)
+	GetEventsByDay(ctx context.Context, date, timeZone string, limit, offset int64) ([]*storage.Event, error)
+	ListEvents(ctx context.Context, from, to time.Time, pageSize int64, pageToken string) (
+		[]*storage.Event,
+		string,
+		error)
+	ListEventsByPeriod(ctx context.Context, day, timeZone string, period calendar.Period, pageSize int64,
+		pageToken string) ([]*storage.Event, string, error)
+	UpdateOccurrence(ctx context.Context, uuid string, recurrenceID time.Time, event *storage.Event,
+		scope calendar.OccurrenceScope) error
+	DeleteOccurrence(ctx context.Context, uuid string, recurrenceID time.Time, scope calendar.OccurrenceScope) error
+	InviteAttendee(ctx context.Context, uuid string, attendee *storage.Attendee) error
+	RemoveAttendee(ctx context.Context, uuid, userID string) error
+	RespondToInvitation(ctx context.Context, uuid string, status storage.RSVPStatus) error
+	FreeBusy(ctx context.Context, userIDs []string, from, to time.Time) (map[string][]calendar.Interval, error)
+	FindAvailableSlots(ctx context.Context, query calendar.SlotQuery) ([]calendar.Interval, error)
+}
+
+var occurrenceScopes = map[pb.OccurrenceScope]calendar.OccurrenceScope{
+	pb.OccurrenceScope_OCCURRENCE_SCOPE_THIS:               calendar.ScopeThis,
+	pb.OccurrenceScope_OCCURRENCE_SCOPE_THIS_AND_FOLLOWING: calendar.ScopeThisAndFollowing,
+	pb.OccurrenceScope_OCCURRENCE_SCOPE_ALL:                calendar.ScopeAll,
 }
 
 func toAppEvent(re *pb.Event) (*storage.Event, error) {
-	return storage.NewEvent("", re.GetTitle(), re.GetDescription(), re.GetDateStart().AsTime(),
-		re.GetDateFinish().AsTime())
+	var (
+		event *storage.Event
+		err   error
+	)
+
+	if re.GetAllDay() {
+		// dates of all-day events are taken in the time zone of the request
+		loc, lerr := storage.LoadLocation(re.GetTimeZone())
+		if lerr != nil {
+			return nil, lerr
+		}
+
+		event, err = storage.NewAllDayEvent("", re.GetTitle(), re.GetDescription(), re.GetDateStart().AsTime().In(loc),
+			re.GetDateFinish().AsTime().In(loc))
+	} else {
+		event, err = storage.NewEvent("", re.GetTitle(), re.GetDescription(), re.GetDateStart().AsTime(),
+			re.GetDateFinish().AsTime())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = event.SetTimeZone(re.GetTimeZone()); err != nil {
+		return nil, err
+	}
+
+	if err = event.SetRecurrence(re.GetRrule()); err != nil {
+		return nil, err
+	}
+
+	if len(re.GetReminderMinutes()) > 0 {
+		offsets := make([]time.Duration, 0, len(re.GetReminderMinutes()))
+		for _, m := range re.GetReminderMinutes() {
+			offsets = append(offsets, time.Duration(m)*time.Minute)
+		}
+
+		if err = event.SetReminders(offsets); err != nil {
+			return nil, err
+		}
+	}
+
+	event.AllowOverlap = re.GetAllowOverlap()
+
+	return event, nil
 }
 
 func fromAppEvent(event *storage.Event) *pb.Event {
 	pbe := pb.Event{
+		Id:          event.ID,
+		UserId:      event.UserID,
 		Title:       event.Title,
 		Description: event.Description,
 		DateStart:   timestamppb.New(event.Start),
 		DateFinish:  timestamppb.New(event.Finish),
+		AllDay:      event.AllDay,
+		TimeZone:    event.TimeZone,
+		Rrule:       event.RRule,
+		CreatedAt:   timestamppb.New(event.CreatedAt),
+		Processed:   event.Processed,
+	}
+
+	for _, r := range event.Reminders {
+		pbe.ReminderMinutes = append(pbe.ReminderMinutes, int32(r/time.Minute))
+	}
+
+	if !event.UpdatedAt.IsZero() {
+		pbe.UpdatedAt = timestamppb.New(event.UpdatedAt)
+	}
+
+	if event.RecurringEventID != "" {
+		pbe.RecurringEventId = event.RecurringEventID
+		pbe.RecurrenceId = timestamppb.New(event.RecurrenceID)
+	}
+
+	for _, d := range event.ExDates {
+		pbe.Exdates = append(pbe.Exdates, timestamppb.New(d))
+	}
+
+	for _, a := range event.Attendees {
+		pbe.Attendees = append(pbe.Attendees, fromAppAttendee(a))
 	}
 
 	return &pbe
@@ -52,12 +146,29 @@ func (s EventServer) CreateEvent(ctx context.Context, req *pb.Event) (*pb.Create
 
 	id, err := s.app.CreateEvent(ctx, e)
 	if err != nil {
+		if errors.Is(err, calendar.ErrEventConflict) {
+			return nil, conflictError(err)
+		}
+
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
 	return &pb.CreateEventResponse{Uuid: id}, nil
 }
 
+func (s EventServer) GetEvent(ctx context.Context, req *pb.GetEventRequest) (*pb.Event, error) {
+	event, err := s.app.GetEvent(ctx, req.GetUuid())
+	if err != nil {
+		if errors.Is(err, calendar.ErrEventNotFound) {
+			return nil, status.Errorf(codes.NotFound, calendar.ErrEventNotFound.Error())
+		}
+
+		return nil, status.Errorf(codes.Internal, calendar.ErrUnexpected.Error())
+	}
+
+	return fromAppEvent(event), nil
+}
+
 func (s EventServer) UpdateEvent(ctx context.Context, req *pb.UpdateEventRequest) (*pb.UpdateEventResponse, error) {
 	e, err := toAppEvent(req.GetEvent())
 	if err != nil {
@@ -71,6 +182,10 @@ func (s EventServer) UpdateEvent(ctx context.Context, req *pb.UpdateEventRequest
 			return nil, status.Errorf(codes.InvalidArgument, calendar.ErrEventNotFound.Error())
 		}
 
+		if errors.Is(err, calendar.ErrEventConflict) {
+			return nil, conflictError(err)
+		}
+
 		return nil, status.Errorf(codes.Internal, calendar.ErrUnexpected.Error())
 	}
 
@@ -86,12 +201,16 @@ func (s EventServer) DeleteEvent(ctx context.Context, req *pb.DeleteEventRequest
 }
 
 func (s EventServer) GetEventsByDay(ctx context.Context, req *pb.GetEventsByDayRequest) (*pb.Events, error) {
-	events, err := s.app.GetEventsByDay(ctx, req.GetDay(), req.GetLimit(), req.GetOffset())
+	events, err := s.app.GetEventsByDay(ctx, req.GetDay(), req.GetTimeZone(), req.GetLimit(), req.GetOffset())
 	if err != nil {
 		if errors.Is(err, calendar.ErrInvalidDateFormat) {
 			return nil, status.Errorf(codes.InvalidArgument, calendar.ErrInvalidDateFormat.Error())
 		}
 
+		if errors.Is(err, calendar.ErrInvalidTimeZone) {
+			return nil, status.Errorf(codes.InvalidArgument, calendar.ErrInvalidTimeZone.Error())
+		}
+
 		return nil, status.Errorf(codes.Internal, calendar.ErrUnexpected.Error())
 	}
 
@@ -102,3 +221,160 @@ func (s EventServer) GetEventsByDay(ctx context.Context, req *pb.GetEventsByDayR
 
 	return &pb.Events{Items: pbEvents}, nil
 }
+
+func (s EventServer) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	events, token, err := s.app.ListEvents(ctx, req.GetFrom().AsTime(), req.GetTo().AsTime(), req.GetPageSize(),
+		req.GetPageToken())
+	if err != nil {
+		return nil, listError(err)
+	}
+
+	return listResponse(events, token), nil
+}
+
+func (s EventServer) ListEventsByWeek(ctx context.Context, req *pb.ListEventsByPeriodRequest) (
+	*pb.ListEventsResponse,
+	error) {
+	return s.listEventsByPeriod(ctx, req, calendar.PeriodWeek)
+}
+
+func (s EventServer) ListEventsByMonth(ctx context.Context, req *pb.ListEventsByPeriodRequest) (
+	*pb.ListEventsResponse,
+	error) {
+	return s.listEventsByPeriod(ctx, req, calendar.PeriodMonth)
+}
+
+func (s EventServer) listEventsByPeriod(ctx context.Context, req *pb.ListEventsByPeriodRequest,
+	period calendar.Period) (*pb.ListEventsResponse, error) {
+	events, token, err := s.app.ListEventsByPeriod(ctx, req.GetDay(), req.GetTimeZone(), period, req.GetPageSize(),
+		req.GetPageToken())
+	if err != nil {
+		return nil, listError(err)
+	}
+
+	return listResponse(events, token), nil
+}
+
+func listResponse(events []*storage.Event, token string) *pb.ListEventsResponse {
+	pbEvents := make([]*pb.Event, 0, len(events))
+	for _, event := range events {
+		pbEvents = append(pbEvents, fromAppEvent(event))
+	}
+
+	return &pb.ListEventsResponse{Items: pbEvents, NextPageToken: token}
+}
+
+func listError(err error) error {
+	for _, e := range []error{
+		calendar.ErrInvalidDateFormat, calendar.ErrInvalidTimeZone, calendar.ErrInvalidRange,
+		calendar.ErrInvalidPageToken,
+	} {
+		if errors.Is(err, e) {
+			return status.Errorf(codes.InvalidArgument, e.Error())
+		}
+	}
+
+	return status.Errorf(codes.Internal, calendar.ErrUnexpected.Error())
+}
+
+func (s EventServer) FreeBusy(ctx context.Context, req *pb.FreeBusyRequest) (*pb.FreeBusyResponse, error) {
+	busy, err := s.app.FreeBusy(ctx, req.GetUserIds(), req.GetFrom().AsTime(), req.GetTo().AsTime())
+	if err != nil {
+		if errors.Is(err, calendar.ErrInvalidRange) || errors.Is(err, calendar.ErrInvalidUsers) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Errorf(codes.Internal, calendar.ErrUnexpected.Error())
+	}
+
+	users := make([]*pb.UserBusy, 0, len(req.GetUserIds()))
+	for _, id := range req.GetUserIds() {
+		user := pb.UserBusy{UserId: id}
+		for _, interval := range busy[id] {
+			user.Busy = append(user.Busy, &pb.TimeInterval{
+				Start:  timestamppb.New(interval.Start),
+				Finish: timestamppb.New(interval.Finish),
+			})
+		}
+
+		users = append(users, &user)
+	}
+
+	return &pb.FreeBusyResponse{Users: users}, nil
+}
+
+// conflictError returns FailedPrecondition with ids of conflicting events in the message and in details.
+func conflictError(err error) error {
+	var conflict *calendar.ConflictError
+	if !errors.As(err, &conflict) {
+		return status.Errorf(codes.FailedPrecondition, calendar.ErrEventConflict.Error())
+	}
+
+	st := status.New(codes.FailedPrecondition, conflict.Error())
+
+	violations := make([]*errdetails.PreconditionFailure_Violation, 0, len(conflict.EventIDs))
+	for _, id := range conflict.EventIDs {
+		violations = append(violations, &errdetails.PreconditionFailure_Violation{
+			Type:        "OVERLAP",
+			Subject:     id,
+			Description: calendar.ErrEventConflict.Error(),
+		})
+	}
+
+	if detailed, derr := st.WithDetails(&errdetails.PreconditionFailure{Violations: violations}); derr == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
+
+func occurrenceError(err error) error {
+	switch {
+	case errors.Is(err, calendar.ErrEventNotFound):
+		return status.Errorf(codes.NotFound, calendar.ErrEventNotFound.Error())
+	case errors.Is(err, calendar.ErrOccurrenceNotFound):
+		return status.Errorf(codes.NotFound, calendar.ErrOccurrenceNotFound.Error())
+	case errors.Is(err, calendar.ErrEventNotRecurring):
+		return status.Errorf(codes.FailedPrecondition, calendar.ErrEventNotRecurring.Error())
+	case errors.Is(err, calendar.ErrEventConflict):
+		return conflictError(err)
+	}
+
+	return status.Errorf(codes.Internal, calendar.ErrUnexpected.Error())
+}
+
+func (s EventServer) UpdateOccurrence(ctx context.Context, req *pb.UpdateOccurrenceRequest) (
+	*pb.UpdateOccurrenceResponse,
+	error) {
+	scope, ok := occurrenceScopes[req.GetScope()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scope: %v", req.GetScope())
+	}
+
+	e, err := toAppEvent(req.GetEvent())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.app.UpdateOccurrence(ctx, req.GetUuid(), req.GetRecurrenceId().AsTime(), e, scope)
+	if err != nil {
+		return nil, occurrenceError(err)
+	}
+
+	return &pb.UpdateOccurrenceResponse{}, nil
+}
+
+func (s EventServer) DeleteOccurrence(ctx context.Context, req *pb.DeleteOccurrenceRequest) (
+	*pb.DeleteOccurrenceResponse,
+	error) {
+	scope, ok := occurrenceScopes[req.GetScope()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid scope: %v", req.GetScope())
+	}
+
+	if err := s.app.DeleteOccurrence(ctx, req.GetUuid(), req.GetRecurrenceId().AsTime(), scope); err != nil {
+		return nil, occurrenceError(err)
+	}
+
+	return &pb.DeleteOccurrenceResponse{}, nil
+}
@@ -0,0 +1,85 @@
+package internalgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/seregproj/calendar/api/proto"
+	"github.com/seregproj/calendar/internal/app/calendar"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// parseClock parses HH:MM into offset from midnight, empty value gives zero offset.
+func parseClock(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q: %w", value, err)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func (s EventServer) FindAvailableSlots(ctx context.Context, req *pb.FindAvailableSlotsRequest) (
+	*pb.FindAvailableSlotsResponse,
+	error) {
+	workdayStart, err := parseClock(req.GetWorkingHoursStart())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	workdayFinish, err := parseClock(req.GetWorkingHoursEnd())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// midnight as the end of working hours means the end of the day
+	if req.GetWorkingHoursEnd() != "" && workdayFinish == 0 {
+		workdayFinish = 24 * time.Hour
+	}
+
+	weekdays := make([]time.Weekday, 0, len(req.GetWorkingDays()))
+	for _, wd := range req.GetWorkingDays() {
+		weekdays = append(weekdays, time.Weekday(wd))
+	}
+
+	slots, err := s.app.FindAvailableSlots(ctx, calendar.SlotQuery{
+		UserIDs:       req.GetUserIds(),
+		Duration:      time.Duration(req.GetDurationMinutes()) * time.Minute,
+		From:          req.GetFrom().AsTime(),
+		To:            req.GetTo().AsTime(),
+		WorkdayStart:  workdayStart,
+		WorkdayFinish: workdayFinish,
+		TimeZone:      req.GetTimeZone(),
+		Weekdays:      weekdays,
+		MaxResults:    int(req.GetMaxResults()),
+	})
+	if err != nil {
+		for _, e := range []error{
+			calendar.ErrInvalidTimeZone, calendar.ErrInvalidRange, calendar.ErrInvalidUsers, calendar.ErrInvalidSlotQuery,
+		} {
+			if errors.Is(err, e) {
+				return nil, status.Errorf(codes.InvalidArgument, e.Error())
+			}
+		}
+
+		return nil, status.Errorf(codes.Internal, calendar.ErrUnexpected.Error())
+	}
+
+	pbSlots := make([]*pb.TimeInterval, 0, len(slots))
+	for _, slot := range slots {
+		pbSlots = append(pbSlots, &pb.TimeInterval{
+			Start:  timestamppb.New(slot.Start),
+			Finish: timestamppb.New(slot.Finish),
+		})
+	}
+
+	return &pb.FindAvailableSlotsResponse{Slots: pbSlots}, nil
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+)
+
+type AttendeeRole string
+
+// roles of attendees as in RFC 5545 ROLE parameter.
+const (
+	RoleRequired AttendeeRole = "REQ-PARTICIPANT"
+	RoleOptional AttendeeRole = "OPT-PARTICIPANT"
+	RoleChair    AttendeeRole = "CHAIR"
+)
+
+type RSVPStatus string
+
+// statuses of invitation responses as in RFC 5545 PARTSTAT parameter.
+const (
+	StatusNeedsAction RSVPStatus = "NEEDS-ACTION"
+	StatusAccepted    RSVPStatus = "ACCEPTED"
+	StatusDeclined    RSVPStatus = "DECLINED"
+	StatusTentative   RSVPStatus = "TENTATIVE"
+)
+
+var (
+	ErrInvalidAttendee     = errors.New("invalid attendee")
+	ErrInvalidRSVPStatus   = errors.New("invalid rsvp status")
+	ErrInvalidAttendeeRole = errors.New("invalid attendee role")
+)
+
+// Attendee is a user invited to the event, the user is identified the same way as event owner.
+type Attendee struct {
+	EventID   string
+	UserID    string
+	Email     string
+	Role      AttendeeRole
+	Status    RSVPStatus
+	UpdatedAt time.Time
+}
+
+// NewAttendee creates invitation of the user waiting for response, the email is optional and is a bare address
+// without display name when set.
+func NewAttendee(userID, email string, role AttendeeRole) (*Attendee, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("empty user id: %w", ErrInvalidAttendee)
+	}
+
+	if email != "" {
+		if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+			return nil, fmt.Errorf("email %q: %w", email, ErrInvalidAttendee)
+		}
+	}
+
+	switch role {
+	case RoleRequired, RoleOptional, RoleChair:
+	default:
+		return nil, fmt.Errorf("role %q: %w", role, ErrInvalidAttendeeRole)
+	}
+
+	return &Attendee{UserID: userID, Email: email, Role: role, Status: StatusNeedsAction}, nil
+}
+
+// Respond sets response of the attendee to the invitation.
+func (a *Attendee) Respond(status RSVPStatus) error {
+	switch status {
+	case StatusNeedsAction, StatusAccepted, StatusDeclined, StatusTentative:
+	default:
+		return fmt.Errorf("status %q: %w", status, ErrInvalidRSVPStatus)
+	}
+
+	a.Status = status
+	a.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// IsNotified checks the attendee should get notifications about the event.
+func (a *Attendee) IsNotified() bool {
+	return a.Status != StatusDeclined
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAttendee(t *testing.T) {
+	for _, email := range []string{"", "user1@example.com"} {
+		a, err := NewAttendee("user1", email, RoleRequired)
+		require.NoError(t, err)
+		require.Equal(t, &Attendee{UserID: "user1", Email: email, Role: RoleRequired, Status: StatusNeedsAction}, a)
+	}
+
+	for _, email := range []string{"user1", "user1@", "User <user1@example.com>", "user1@example.com\r\nBcc: x@example.com"} {
+		_, err := NewAttendee("user1", email, RoleRequired)
+		require.ErrorIs(t, err, ErrInvalidAttendee, email)
+	}
+
+	_, err := NewAttendee("", "user1@example.com", RoleRequired)
+	require.ErrorIs(t, err, ErrInvalidAttendee)
+
+	_, err = NewAttendee("user1", "user1@example.com", "GUEST")
+	require.ErrorIs(t, err, ErrInvalidAttendeeRole)
+}
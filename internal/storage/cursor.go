@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is a position in events sorted by start and id, pages after it are stable
+// when events are inserted before it.
+type Cursor struct {
+	Start time.Time
+	ID    string
+}
+
+// CursorOf returns cursor pointing at the event.
+func CursorOf(e *Event) *Cursor {
+	return &Cursor{Start: e.Start, ID: e.ID}
+}
+
+// Encode returns opaque page token of the cursor.
+func (c *Cursor) Encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.Start.UTC().Format(time.RFC3339Nano) + "|" + c.ID))
+}
+
+// DecodeCursor parses page token made by Encode, empty token means the first page and gives nil cursor.
+func DecodeCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidCursor)
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("malformed token: %w", ErrInvalidCursor)
+	}
+
+	start, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidCursor)
+	}
+
+	return &Cursor{Start: start, ID: parts[1]}, nil
+}
+
+// Passed checks the event is at the cursor or before it, so it was on the previous pages.
+func (c *Cursor) Passed(e *Event) bool {
+	if !e.Start.Equal(c.Start) {
+		return e.Start.Before(c.Start)
+	}
+
+	return e.ID <= c.ID
+}
+
+// SortByStart sorts events by start and id, the order cursors point into.
+func SortByStart(events []*Event) {
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].Start.Equal(events[j].Start) {
+			return events[i].Start.Before(events[j].Start)
+		}
+
+		return events[i].ID < events[j].ID
+	})
+}
+
+// PageEventsAfter returns at most limit events of sorted events following the cursor, nil cursor means the beginning.
+func PageEventsAfter(events []*Event, after *Cursor, limit int64) []*Event {
+	page := make([]*Event, 0)
+
+	for _, e := range events {
+		if int64(len(page)) >= limit {
+			break
+		}
+
+		if after != nil && after.Passed(e) {
+			continue
+		}
+
+		page = append(page, e)
+	}
+
+	return page
+}
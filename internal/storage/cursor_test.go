@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorEncodeDecode(t *testing.T) {
+	c := &Cursor{Start: time.Date(2021, 5, 3, 10, 0, 0, 5, time.UTC), ID: "event1"}
+
+	decoded, err := DecodeCursor(c.Encode())
+	require.NoError(t, err)
+	require.Equal(t, c, decoded)
+
+	decoded, err = DecodeCursor("")
+	require.NoError(t, err)
+	require.Nil(t, decoded)
+
+	for _, token := range []string{"!", "bm90LWEtdG9rZW4", c.Encode()[:10]} {
+		_, err = DecodeCursor(token)
+		require.ErrorIs(t, err, ErrInvalidCursor, token)
+	}
+}
+
+func TestPageEventsAfter(t *testing.T) {
+	start := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	events := []*Event{
+		{ID: "b", Start: start},
+		{ID: "a", Start: start.Add(time.Hour)},
+		{ID: "a", Start: start},
+	}
+	SortByStart(events)
+
+	require.Equal(t, []*Event{events[0], events[1]}, PageEventsAfter(events, nil, 2))
+	require.Equal(t, []*Event{events[2]}, PageEventsAfter(events, CursorOf(events[1]), 2))
+	require.Equal(t, []*Event{}, PageEventsAfter(events, CursorOf(events[2]), 2))
+	require.Equal(t, "a", events[0].ID)
+	require.Equal(t, start.Add(time.Hour), events[2].Start)
+}
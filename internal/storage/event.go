@@ -8,17 +8,62 @@ import (
 
 type Event struct {
 	ID          string
+	UserID      string
 	Title       string
 	Description string
 	Start       time.Time
 	Finish      time.Time
+	// AllDay events have date-only Start and exclusive Finish as midnights in UTC, the dates are the same
+	// in every time zone.
+	AllDay bool
+	// TimeZone is IANA time zone the event is scheduled in, recurrences keep wall clock time in it.
+	TimeZone string
+	RRule    string
+	// ExDates are original starts of series occurrences which are cancelled or overridden.
+	ExDates []time.Time
+	// RecurringEventID and RecurrenceID identify the series and the original start of an occurrence,
+	// they are set for series instances and for overridden occurrences stored as separate events.
+	RecurringEventID string
+	RecurrenceID     time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	// Reminders are offsets before the start of every occurrence to notify at.
+	Reminders []time.Duration
+	// Processed is set by storage after all reminders of single event are sent.
+	Processed bool
+	// Attendees are loaded along with single event only.
+	Attendees []*Attendee
+	// AllowOverlap skips the check of conflicts with other events of the owner on create or update, not stored.
+	AllowOverlap bool
 }
 
 var (
 	ErrDatestartBeforeNow   = errors.New("datestart should be in future")
 	ErrDatestartAfterFinish = errors.New("datestart should be before datefinish")
+	ErrInvalidTimeZone      = errors.New("invalid time zone")
+	ErrNoOccurrencesBefore  = errors.New("series has no occurrences before the occurrence")
 )
 
+// DefaultTimeZone is used for events and queries without time zone.
+const DefaultTimeZone = "UTC"
+
+// endOfTime bounds expansion of series which end by COUNT or UNTIL.
+var endOfTime = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+// LoadLocation loads IANA time zone, empty name means DefaultTimeZone.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		name = DefaultTimeZone
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, ErrInvalidTimeZone)
+	}
+
+	return loc, nil
+}
+
 func NewEvent(id, title, description string, dateStart, dateFinish time.Time) (*Event, error) {
 	if dateStart.Before(time.Now()) {
 		return nil, fmt.Errorf("invalid datestart: %v, %w", dateStart, ErrDatestartBeforeNow)
@@ -28,14 +73,32 @@ func NewEvent(id, title, description string, dateStart, dateFinish time.Time) (*
 		return nil, fmt.Errorf("invalid datestart: %v, %w", dateStart, ErrDatestartAfterFinish)
 	}
 
-	ds, err := time.Parse("2006-01-02 15:04", dateStart.Format("2006-01-02 15:04"))
-	if err != nil {
-		return nil, fmt.Errorf("cant parse datestart: %w", err)
+	return &Event{
+		ID:          id,
+		Title:       title,
+		Description: description,
+		Start:       dateStart.Truncate(time.Minute),
+		Finish:      dateFinish.Truncate(time.Minute),
+		TimeZone:    DefaultTimeZone,
+	}, nil
+}
+
+// NewAllDayEvent creates all-day event from dates of dateStart and dateFinish, finish is exclusive,
+// so the finish at the same date as the start makes one-day event.
+func NewAllDayEvent(id, title, description string, dateStart, dateFinish time.Time) (*Event, error) {
+	ds := dateOf(dateStart)
+	df := dateOf(dateFinish)
+
+	if h, m, s := dateFinish.Clock(); h+m+s > 0 || df.Equal(ds) {
+		df = df.AddDate(0, 0, 1)
 	}
 
-	df, err := time.Parse("2006-01-02 15:04", dateFinish.Format("2006-01-02 15:04"))
-	if err != nil {
-		return nil, fmt.Errorf("cant parse datefinish: %w", err)
+	if ds.Before(dateOf(time.Now().In(dateStart.Location()))) {
+		return nil, fmt.Errorf("invalid datestart: %v, %w", dateStart, ErrDatestartBeforeNow)
+	}
+
+	if ds.After(df) {
+		return nil, fmt.Errorf("invalid datestart: %v, %w", dateStart, ErrDatestartAfterFinish)
 	}
 
 	return &Event{
@@ -44,5 +107,238 @@ func NewEvent(id, title, description string, dateStart, dateFinish time.Time) (*
 		Description: description,
 		Start:       ds,
 		Finish:      df,
+		AllDay:      true,
+		TimeZone:    DefaultTimeZone,
 	}, nil
 }
+
+// dateOf returns date of t in its location as UTC midnight.
+func dateOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// FloatingWindow converts interval to the interval of dates it touches as UTC midnights.
+func FloatingWindow(from, to time.Time) (time.Time, time.Time) {
+	dateTo := dateOf(to)
+	if h, m, s := to.Clock(); h+m+s > 0 || to.Nanosecond() > 0 {
+		dateTo = dateTo.AddDate(0, 0, 1)
+	}
+
+	return dateOf(from), dateTo
+}
+
+// SetTimeZone validates IANA time zone and moves event times to it, dates of all-day events are kept.
+func (e *Event) SetTimeZone(name string) error {
+	loc, err := LoadLocation(name)
+	if err != nil {
+		return fmt.Errorf("invalid time zone %q: %w", name, err)
+	}
+
+	e.TimeZone = loc.String()
+	if !e.AllDay {
+		e.Start = e.Start.In(loc)
+		e.Finish = e.Finish.In(loc)
+	}
+
+	return nil
+}
+
+// Location returns time zone of the event or location of its start for events without one.
+// All-day events are floating, so UTC is used for them.
+func (e *Event) Location() *time.Location {
+	if e.AllDay {
+		return time.UTC
+	}
+
+	if e.TimeZone == "" {
+		return e.Start.Location()
+	}
+
+	loc, err := LoadLocation(e.TimeZone)
+	if err != nil {
+		return e.Start.Location()
+	}
+
+	return loc
+}
+
+// BusyTime returns the times the event takes, dates of all-day events are taken in the time zone of the event.
+func (e *Event) BusyTime() (time.Time, time.Time) {
+	if !e.AllDay {
+		return e.Start, e.Finish
+	}
+
+	loc, err := LoadLocation(e.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	return midnightIn(e.Start, loc), midnightIn(e.Finish, loc)
+}
+
+// midnightIn returns the midnight of the date in the location.
+func midnightIn(date time.Time, loc *time.Location) time.Time {
+	y, m, d := date.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// SetRecurrence validates and sets RFC 5545 RRULE value, empty rule makes event non-recurring.
+func (e *Event) SetRecurrence(rrule string) error {
+	if rrule == "" {
+		e.RRule = ""
+
+		return nil
+	}
+
+	rule, err := ParseRecurrenceRule(rrule)
+	if err != nil {
+		return fmt.Errorf("invalid rrule: %w", err)
+	}
+
+	e.RRule = rule.String()
+
+	return nil
+}
+
+func (e *Event) IsRecurring() bool {
+	return e.RRule != ""
+}
+
+// EndsBefore checks the event and all its occurrences finish before t, series without COUNT or UNTIL never end.
+func (e *Event) EndsBefore(t time.Time) (bool, error) {
+	if !e.IsRecurring() {
+		return e.Finish.Before(t), nil
+	}
+
+	rule, err := ParseRecurrenceRule(e.RRule)
+	if err != nil {
+		return false, fmt.Errorf("cant parse rrule of event %s: %w", e.ID, err)
+	}
+
+	if rule.Count == 0 && rule.Until.IsZero() {
+		return false, nil
+	}
+
+	occurrences, err := e.Occurrences(t, endOfTime)
+	if err != nil {
+		return false, err
+	}
+
+	return len(occurrences) == 0, nil
+}
+
+// Occurrences returns instances of the event which overlap [from, to), all-day events overlap
+// when any of their dates is a date of the interval in its location.
+func (e *Event) Occurrences(from, to time.Time) ([]*Event, error) {
+	if e.AllDay {
+		from, to = FloatingWindow(from, to)
+	}
+
+	if !e.IsRecurring() {
+		if !overlaps(e.Start, e.Finish, from, to) {
+			return []*Event{}, nil
+		}
+
+		event := *e
+
+		return []*Event{&event}, nil
+	}
+
+	rule, err := ParseRecurrenceRule(e.RRule)
+	if err != nil {
+		return nil, fmt.Errorf("cant parse rrule of event %s: %w", e.ID, err)
+	}
+
+	duration := e.Finish.Sub(e.Start)
+	starts := rule.Between(e.Start.In(e.Location()), from.Add(-duration), to)
+	events := make([]*Event, 0, len(starts))
+
+	for _, start := range starts {
+		if e.IsExDate(start) || !overlaps(start, start.Add(duration), from, to) {
+			continue
+		}
+
+		event := *e
+		event.Start = start
+		event.Finish = start.Add(duration)
+		event.RecurringEventID = e.ID
+		event.RecurrenceID = start
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// overlaps checks [start, finish) overlaps [from, to), zero length event overlaps when starts in it.
+func overlaps(start, finish, from, to time.Time) bool {
+	return start.Before(to) && (finish.After(from) || !start.Before(from))
+}
+
+// HasOccurrence checks the series rule produces an occurrence starting at recurrenceID.
+// Excluded dates are not taken into account.
+func (e *Event) HasOccurrence(recurrenceID time.Time) (bool, error) {
+	if !e.IsRecurring() {
+		return false, nil
+	}
+
+	rule, err := ParseRecurrenceRule(e.RRule)
+	if err != nil {
+		return false, fmt.Errorf("cant parse rrule of event %s: %w", e.ID, err)
+	}
+
+	return len(rule.Between(e.Start.In(e.Location()), recurrenceID, recurrenceID.Add(time.Nanosecond))) > 0, nil
+}
+
+func (e *Event) IsExDate(recurrenceID time.Time) bool {
+	for _, d := range e.ExDates {
+		if d.Equal(recurrenceID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *Event) AddExDate(recurrenceID time.Time) {
+	if !e.IsExDate(recurrenceID) {
+		e.ExDates = append(e.ExDates, recurrenceID)
+	}
+}
+
+// TruncateSeries ends the series right before the occurrence starting at recurrenceID
+// and returns the rule for the rest of the original series. ErrNoOccurrencesBefore is returned for the first
+// occurrence, the series is left intact then.
+func (e *Event) TruncateSeries(recurrenceID time.Time) (*RecurrenceRule, error) {
+	rule, err := ParseRecurrenceRule(e.RRule)
+	if err != nil {
+		return nil, fmt.Errorf("cant parse rrule of event %s: %w", e.ID, err)
+	}
+
+	before := len(rule.Between(e.Start.In(e.Location()), e.Start, recurrenceID))
+	if before == 0 {
+		return nil, ErrNoOccurrencesBefore
+	}
+
+	rest := *rule
+	if rule.Count > 0 {
+		rule.Count = before
+		rest.Count -= before
+	} else {
+		rule.Until = recurrenceID.Add(-time.Second)
+	}
+
+	exDates := make([]time.Time, 0, len(e.ExDates))
+	for _, d := range e.ExDates {
+		if d.Before(recurrenceID) {
+			exDates = append(exDates, d)
+		}
+	}
+
+	e.RRule = rule.String()
+	e.ExDates = exDates
+
+	return &rest, nil
+}
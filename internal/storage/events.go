@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ExpandEvents expands events and their recurrences into instances overlapping [from, to)
+// sorted by start and id with all-day events first.
+func ExpandEvents(events []*Event, from, to time.Time) ([]*Event, error) {
+	expanded := make([]*Event, 0, len(events))
+
+	for _, e := range events {
+		occurrences, err := e.Occurrences(from, to)
+		if err != nil {
+			return nil, fmt.Errorf("cant expand event: %w", err)
+		}
+
+		expanded = append(expanded, occurrences...)
+	}
+
+	sort.Slice(expanded, func(i, j int) bool {
+		if expanded[i].AllDay != expanded[j].AllDay {
+			return expanded[i].AllDay
+		}
+
+		if !expanded[i].Start.Equal(expanded[j].Start) {
+			return expanded[i].Start.Before(expanded[j].Start)
+		}
+
+		return expanded[i].ID < expanded[j].ID
+	})
+
+	return expanded, nil
+}
+
+// BusyEvents expands events into instances which make their owners busy in [from, to) sorted by start and id.
+// All-day instances are busy from the midnight of their first date till the midnight after the last one
+// in the time zone of the event, their Start and Finish are set to these times. Zero length events leave
+// the time free.
+func BusyEvents(events []*Event, from, to time.Time) ([]*Event, error) {
+	// dates of all-day events are taken around the interval, they are busy at other times in other time zones
+	expanded, err := ExpandEvents(events, from.AddDate(0, 0, -1), to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	busy := make([]*Event, 0, len(expanded))
+	for _, e := range expanded {
+		e.Start, e.Finish = e.BusyTime()
+		if e.Finish.After(e.Start) && e.Start.Before(to) && e.Finish.After(from) {
+			busy = append(busy, e)
+		}
+	}
+
+	SortByStart(busy)
+
+	return busy, nil
+}
+
+// PageEvents returns page of events by limit and offset.
+func PageEvents(events []*Event, limit, offset int64) []*Event {
+	if offset >= int64(len(events)) {
+		return []*Event{}
+	}
+
+	events = events[offset:]
+	if limit < int64(len(events)) {
+		events = events[:limit]
+	}
+
+	return events
+}
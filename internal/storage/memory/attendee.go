@@ -0,0 +1,38 @@
+package memorystorage
+
+import (
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+type Attendee struct {
+	EventID    string
+	UserID     string
+	Email      string
+	Role       string
+	Status     string
+	DateUpdate time.Time
+}
+
+func NewAttendeeFromApp(a *storage.Attendee) *Attendee {
+	return &Attendee{
+		EventID:    a.EventID,
+		UserID:     a.UserID,
+		Email:      a.Email,
+		Role:       string(a.Role),
+		Status:     string(a.Status),
+		DateUpdate: a.UpdatedAt,
+	}
+}
+
+func (a *Attendee) ToApp() storage.Attendee {
+	return storage.Attendee{
+		EventID:   a.EventID,
+		UserID:    a.UserID,
+		Email:     a.Email,
+		Role:      storage.AttendeeRole(a.Role),
+		Status:    storage.RSVPStatus(a.Status),
+		UpdatedAt: a.DateUpdate,
+	}
+}
@@ -7,21 +7,35 @@ import (
 )
 
 type Event struct {
-	ID             string
-	Title          string
-	Description    string
-	DatetimeStart  time.Time
-	DatetimeFinish time.Time
-	Processed      bool
+	ID               string
+	UserID           string
+	Title            string
+	Description      string
+	DatetimeStart    time.Time
+	DatetimeFinish   time.Time
+	AllDay           bool
+	TimeZone         string
+	RRule            string
+	ExDates          []time.Time
+	RecurringEventID string
+	RecurrenceID     time.Time
+	DateAdd          time.Time
+	DateUpdate       time.Time
+	Reminders        []*Reminder
+}
+
+type Reminder struct {
+	Offset         time.Duration
+	LastOccurrence time.Time
+	// LockedUntil is end of the lease of the scheduler which claimed the reminder
+	LockedUntil time.Time
+	// RemindAt is time to check the reminder at next, zero when no occurrences are left
+	RemindAt time.Time
 }
 
 func NewFromApp(e *storage.Event) *Event {
 	event := Event{}
-	event.ID = e.ID
-	event.Title = e.Title
-	event.Description = e.Description
-	event.DatetimeStart = e.Start
-	event.DatetimeFinish = e.Finish
+	event.UpdateFromApp(e)
 
 	return &event
 }
@@ -29,18 +43,102 @@ func NewFromApp(e *storage.Event) *Event {
 func (e *Event) ToApp() storage.Event {
 	event := storage.Event{}
 	event.ID = e.ID
+	event.UserID = e.UserID
 	event.Title = e.Title
 	event.Description = e.Description
 	event.Start = e.DatetimeStart
 	event.Finish = e.DatetimeFinish
+	event.AllDay = e.AllDay
+	event.TimeZone = e.TimeZone
+	event.RRule = e.RRule
+	event.ExDates = copyTimes(e.ExDates)
+	event.RecurringEventID = e.RecurringEventID
+	event.RecurrenceID = e.RecurrenceID
+	event.CreatedAt = e.DateAdd
+	event.UpdatedAt = e.DateUpdate
+	event.Processed = e.isProcessed()
+
+	for _, r := range e.Reminders {
+		event.Reminders = append(event.Reminders, r.Offset)
+	}
 
 	return event
 }
 
 func (e *Event) UpdateFromApp(event *storage.Event) {
 	e.ID = event.ID
+	e.UserID = event.UserID
 	e.Title = event.Title
 	e.Description = event.Description
 	e.DatetimeStart = event.Start
 	e.DatetimeFinish = event.Finish
+	e.AllDay = event.AllDay
+	e.TimeZone = event.TimeZone
+	e.RRule = event.RRule
+	e.ExDates = copyTimes(event.ExDates)
+	e.RecurringEventID = event.RecurringEventID
+	e.RecurrenceID = event.RecurrenceID
+	e.DateAdd = event.CreatedAt
+	e.DateUpdate = event.UpdatedAt
+	e.Reminders = mergeReminders(e.Reminders, event.Reminders)
+	for _, r := range e.Reminders {
+		r.RemindAt = e.nextRemindAt(r)
+	}
+}
+
+// nextRemindAt returns time to check the reminder at by the occurrence it was sent for last.
+func (e *Event) nextRemindAt(r *Reminder) time.Time {
+	event := e.ToApp()
+
+	remindAt, err := event.NextRemindAt(storage.Reminder{EventID: e.ID, Offset: r.Offset}, r.LastOccurrence)
+	if err != nil {
+		// the reminder is checked at once and the claim reports the error
+		return e.DatetimeStart.Add(-r.Offset)
+	}
+
+	return remindAt
+}
+
+// isProcessed checks all reminders of single event are sent for its current start.
+func (e *Event) isProcessed() bool {
+	if e.RRule != "" || len(e.Reminders) == 0 {
+		return false
+	}
+
+	for _, r := range e.Reminders {
+		if r.LastOccurrence.Before(e.DatetimeStart) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mergeReminders returns reminders with the offsets, state of reminders with the same offsets is kept.
+func mergeReminders(reminders []*Reminder, offsets []time.Duration) []*Reminder {
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	merged := make([]*Reminder, 0, len(offsets))
+	for _, offset := range offsets {
+		reminder := &Reminder{Offset: offset}
+		for _, r := range reminders {
+			if r.Offset == offset {
+				reminder = r
+			}
+		}
+
+		merged = append(merged, reminder)
+	}
+
+	return merged
+}
+
+func copyTimes(times []time.Time) []time.Time {
+	if times == nil {
+		return nil
+	}
+
+	return append(make([]time.Time, 0, len(times)), times...)
 }
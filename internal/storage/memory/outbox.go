@@ -0,0 +1,35 @@
+package memorystorage
+
+import (
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+type OutboxMessage struct {
+	ID          int64
+	Key         string
+	Payload     []byte
+	DateAdd     time.Time
+	LockedUntil time.Time
+	DateSent    time.Time
+}
+
+func NewOutboxMessageFromApp(m *storage.OutboxMessage) *OutboxMessage {
+	return &OutboxMessage{
+		ID:      m.ID,
+		Key:     m.Key,
+		Payload: append([]byte{}, m.Payload...),
+		DateAdd: m.CreatedAt,
+	}
+}
+
+func (m *OutboxMessage) ToApp() storage.OutboxMessage {
+	return storage.OutboxMessage{
+		ID:        m.ID,
+		Key:       m.Key,
+		Payload:   append([]byte{}, m.Payload...),
+		CreatedAt: m.DateAdd,
+		SentAt:    m.DateSent,
+	}
+}
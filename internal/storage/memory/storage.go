@@ -14,21 +14,27 @@ import (
 type Storage struct {
 	sync.RWMutex
 	events map[string]*Event
+	// attendees of events by event id and user id
+	attendees map[string]map[string]*Attendee
+	// outbox messages in order of ids
+	outbox       []*OutboxMessage
+	outboxKeys   map[string]bool
+	lastOutboxID int64
+	// archive of purged events by id, their reminders are kept in them
+	archive map[string]*Event
+	// archive of attendees of purged events by event id and user id
+	archivedAttendees map[string]map[string]*Attendee
 }
 
 func New() *Storage {
 	return &Storage{
-		events: make(map[string]*Event),
-	}
-}
+		events:     make(map[string]*Event),
+		attendees:  make(map[string]map[string]*Attendee),
+		outboxKeys: make(map[string]bool),
+		archive:    make(map[string]*Event),
 
-func (s *Storage) ExistsEventByID(ctx context.Context, uuid string) (bool, error) {
-	s.RLock()
-	defer s.RUnlock()
-
-	_, ok := s.events[uuid]
-
-	return ok, nil
+		archivedAttendees: make(map[string]map[string]*Attendee),
+	}
 }
 
 func (s *Storage) GetEventByID(ctx context.Context, uuid string) (*storage.Event, error) {
@@ -64,7 +70,7 @@ func (s *Storage) UpdateEvent(ctx context.Context, uuid string, event *storage.E
 
 	e, ok := s.events[uuid]
 
-	if !ok {
+	if !ok || e.UserID != event.UserID {
 		return calendar.ErrEventNotFound
 	}
 
@@ -74,122 +80,475 @@ func (s *Storage) UpdateEvent(ctx context.Context, uuid string, event *storage.E
 	return nil
 }
 
-func (s *Storage) DeleteEvent(ctx context.Context, uuid string) error {
+func (s *Storage) DeleteEvent(ctx context.Context, userID, uuid string) error {
 	s.Lock()
 	defer s.Unlock()
 
-	_, ok := s.events[uuid]
+	e, ok := s.events[uuid]
 
-	if !ok {
+	if !ok || e.UserID != userID {
 		return calendar.ErrEventNotFound
 	}
 
 	delete(s.events, uuid)
+	delete(s.attendees, uuid)
+
+	// overridden occurrences are removed along with their series
+	for id, e := range s.events {
+		if e.RecurringEventID == uuid {
+			delete(s.events, id)
+			delete(s.attendees, id)
+		}
+	}
 
 	return nil
 }
 
-func (s *Storage) GetEventsByDaySorted(ctx context.Context, date time.Time, limit, offset int64) (
-	[]*storage.Event,
-	error) {
+// SplitSeries stores the truncated series, deletes its overridden occurrences starting from recurrenceID and
+// creates the rest of the series with attendees of the series, the rest is skipped when nil.
+func (s *Storage) SplitSeries(ctx context.Context, series *storage.Event, recurrenceID time.Time,
+	rest *storage.Event) error {
+	s.Lock()
+	defer s.Unlock()
+
+	e, ok := s.events[series.ID]
+	if !ok || e.UserID != series.UserID {
+		return calendar.ErrEventNotFound
+	}
+
+	if rest != nil {
+		if _, ok := s.events[rest.ID]; ok {
+			return calendar.ErrEventAlreadyExists
+		}
+	}
+
+	for id, o := range s.events {
+		if o.RecurringEventID == series.ID && !o.RecurrenceID.Before(recurrenceID) {
+			delete(s.events, id)
+			delete(s.attendees, id)
+		}
+	}
+
+	e.UpdateFromApp(series)
+
+	if rest == nil {
+		return nil
+	}
+
+	s.events[rest.ID] = NewFromApp(rest)
+
+	if len(s.attendees[series.ID]) > 0 {
+		s.attendees[rest.ID] = make(map[string]*Attendee, len(s.attendees[series.ID]))
+		for userID, a := range s.attendees[series.ID] {
+			copied := *a
+			copied.EventID = rest.ID
+			s.attendees[rest.ID][userID] = &copied
+		}
+	}
+
+	return nil
+}
+
+// PurgeEvents deletes at most limit of events finished before the time, archiving them if asked.
+// Single events and overridden occurrences go first, series are purged after all their overrides.
+func (s *Storage) PurgeEvents(ctx context.Context, before time.Time, archive bool, limit int64) (int64, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	overridden := make(map[string]bool)
+	for _, e := range s.events {
+		if e.RecurringEventID != "" {
+			overridden[e.RecurringEventID] = true
+		}
+	}
+
+	single := make([]*Event, 0)
+	series := make([]*Event, 0)
+
+	for _, e := range s.events {
+		if overridden[e.ID] {
+			continue
+		}
+
+		eventApp := e.ToApp()
+
+		ended, err := eventApp.EndsBefore(before)
+		if err != nil {
+			return 0, fmt.Errorf("cant check event end: %w", err)
+		}
+
+		switch {
+		case !ended:
+		case eventApp.IsRecurring():
+			series = append(series, e)
+		default:
+			single = append(single, e)
+		}
+	}
+
+	sort.Slice(single, func(i, j int) bool {
+		return single[i].DatetimeFinish.Before(single[j].DatetimeFinish)
+	})
+
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].DatetimeStart.Before(series[j].DatetimeStart)
+	})
+
+	var purged int64
+
+	for _, e := range append(single, series...) {
+		if purged >= limit {
+			break
+		}
+
+		if archive {
+			s.archive[e.ID] = e
+			if attendees, ok := s.attendees[e.ID]; ok {
+				s.archivedAttendees[e.ID] = attendees
+			}
+		}
+
+		delete(s.events, e.ID)
+		delete(s.attendees, e.ID)
+		purged++
+	}
+
+	return purged, nil
+}
+
+// ArchivedEvents returns purged events kept in the archive in order of ids.
+func (s *Storage) ArchivedEvents(ctx context.Context) ([]*storage.Event, error) {
 	s.RLock()
 	defer s.RUnlock()
 
-	events := make([]*storage.Event, 0, limit)
-	dateFrom, err := time.Parse("2006-01-02", date.Format("2006-01-02"))
-	if err != nil {
-		return events, fmt.Errorf("cant parse date: %w", err)
+	events := make([]*storage.Event, 0, len(s.archive))
+	for _, e := range s.archive {
+		eventApp := e.ToApp()
+		events = append(events, &eventApp)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+
+	return events, nil
+}
+
+// ArchivedAttendees returns attendees of the purged event kept in the archive in order of user ids.
+func (s *Storage) ArchivedAttendees(ctx context.Context, eventID string) ([]*storage.Attendee, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	attendees := make([]*storage.Attendee, 0, len(s.archivedAttendees[eventID]))
+	for _, a := range s.archivedAttendees[eventID] {
+		attendeeApp := a.ToApp()
+		attendees = append(attendees, &attendeeApp)
+	}
+
+	sort.Slice(attendees, func(i, j int) bool {
+		return attendees[i].UserID < attendees[j].UserID
+	})
+
+	return attendees, nil
+}
+
+func (s *Storage) GetEventOverrides(ctx context.Context, uuid string) ([]*storage.Event, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	events := make([]*storage.Event, 0)
+	for _, e := range s.events {
+		if e.RecurringEventID == uuid {
+			eventApp := e.ToApp()
+			events = append(events, &eventApp)
+		}
 	}
 
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].RecurrenceID.Before(events[j].RecurrenceID)
+	})
+
+	return events, nil
+}
+
+func (s *Storage) GetEventsByDaySorted(ctx context.Context, userID string, date time.Time, limit, offset int64) (
+	[]*storage.Event,
+	error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	// the day is taken in location of date, so it may be shorter or longer than 24h on DST transitions
+	y, m, d := date.Date()
+	dateFrom := time.Date(y, m, d, 0, 0, 0, 0, date.Location())
 	dateTo := dateFrom.AddDate(0, 0, 1)
 
-	sortedEvents := make([]*Event, 0, len(s.events))
+	candidates := make([]*storage.Event, 0, len(s.events))
 	for _, v := range s.events {
 		select {
 		case <-ctx.Done():
-			return events, nil
+			return []*storage.Event{}, nil
 		default:
 		}
 
-		sortedEvents = append(sortedEvents, v)
+		if v.UserID != userID {
+			continue
+		}
+
+		eventApp := v.ToApp()
+		candidates = append(candidates, &eventApp)
 	}
 
-	sort.Slice(sortedEvents, func(i, j int) bool {
-		return sortedEvents[i].DatetimeStart.Before(sortedEvents[j].DatetimeStart)
-	})
+	events, err := storage.ExpandEvents(candidates, dateFrom, dateTo)
+	if err != nil {
+		return []*storage.Event{}, fmt.Errorf("cant expand events: %w", err)
+	}
+
+	return storage.PageEvents(events, limit, offset), nil
+}
 
-	for _, v := range sortedEvents {
+func (s *Storage) GetEventsByRangeSorted(ctx context.Context, userID string, from, to time.Time,
+	after *storage.Cursor, limit int64) ([]*storage.Event, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	candidates := make([]*storage.Event, 0, len(s.events))
+	for _, v := range s.events {
 		select {
 		case <-ctx.Done():
-			return events, nil
+			return []*storage.Event{}, nil
 		default:
 		}
 
-		if dateFrom.Before(v.DatetimeStart) && dateTo.After(v.DatetimeStart) {
-			if offset > 0 {
-				offset--
+		if v.UserID != userID {
+			continue
+		}
 
-				continue
-			}
+		eventApp := v.ToApp()
+		candidates = append(candidates, &eventApp)
+	}
 
-			eventApp := v.ToApp()
-			events = append(events, &eventApp)
+	events, err := storage.ExpandEvents(candidates, from, to)
+	if err != nil {
+		return []*storage.Event{}, fmt.Errorf("cant expand events: %w", err)
+	}
 
-			limit--
-		}
+	storage.SortByStart(events)
 
-		if limit == 0 {
-			break
+	return storage.PageEventsAfter(events, after, limit), nil
+}
+
+func (s *Storage) GetBusyEventsOfUsers(ctx context.Context, userIDs []string, from, to time.Time) (
+	[]*storage.Event,
+	error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	users := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		users[id] = true
+	}
+
+	candidates := make([]*storage.Event, 0, len(s.events))
+	for _, v := range s.events {
+		if !users[v.UserID] {
+			continue
 		}
+
+		eventApp := v.ToApp()
+		candidates = append(candidates, &eventApp)
+	}
+
+	events, err := storage.BusyEvents(candidates, from, to)
+	if err != nil {
+		return []*storage.Event{}, fmt.Errorf("cant expand events: %w", err)
 	}
 
 	return events, nil
 }
 
-func (s *Storage) GetUnprocessedActualEvents(ctx context.Context, limit int64) ([]*storage.Event, error) {
-	s.RLock()
-	defer s.RUnlock()
+// ClaimDueReminders returns due reminders not claimed by others and leases them till now + lease,
+// it also returns number of reminders skipped being late more than maxDelay.
+func (s *Storage) ClaimDueReminders(ctx context.Context, now time.Time, lease, maxDelay time.Duration, limit int64) (
+	[]*storage.DueReminder,
+	int64,
+	error) {
+	s.Lock()
+	defer s.Unlock()
 
-	events := make([]*storage.Event, 0, limit)
+	reminders := make([]*storage.DueReminder, 0)
+
+	var skipped int64
 
 	for _, v := range s.events {
 		select {
 		case <-ctx.Done():
-			return events, nil
+			return nil, 0, ctx.Err()
 		default:
 		}
 
-		if time.Now().Before(v.DatetimeStart) {
+		eventApp := v.ToApp()
+		for _, r := range v.Reminders {
+			if r.LockedUntil.After(now) || r.RemindAt.IsZero() || r.RemindAt.After(now) {
+				continue
+			}
+
+			check, err := eventApp.CheckReminder(storage.Reminder{
+				EventID: v.ID, Offset: r.Offset, LastOccurrence: r.LastOccurrence, RemindAt: r.RemindAt,
+			}, now, maxDelay)
+			if err != nil {
+				return nil, 0, fmt.Errorf("cant check reminder: %w", err)
+			}
+
+			if check.Skipped {
+				skipped++
+			}
+
+			if check.Due != nil {
+				reminders = append(reminders, check.Due)
+			}
+
+			r.RemindAt = check.RemindAt
+		}
+	}
+
+	reminders = storage.SortDueReminders(reminders, limit)
+	for _, due := range reminders {
+		for _, r := range s.events[due.EventID].Reminders {
+			if r.Offset == due.Offset {
+				r.LockedUntil = now.Add(lease)
+			}
+		}
+	}
+
+	return reminders, skipped, nil
+}
+
+// UpdateReminderAsProcessed stores the reminder is sent for its occurrence along with outbox messages about it,
+// messages with keys stored before are skipped.
+func (s *Storage) UpdateReminderAsProcessed(ctx context.Context, reminder *storage.DueReminder,
+	outbox []*storage.OutboxMessage) error {
+	s.Lock()
+	defer s.Unlock()
+
+	e, ok := s.events[reminder.EventID]
+	if !ok {
+		return calendar.ErrEventNotFound
+	}
+
+	for _, r := range e.Reminders {
+		if r.Offset != reminder.Offset {
 			continue
 		}
 
-		if !v.Processed {
-			eventApp := v.ToApp()
-			events = append(events, &eventApp)
+		if reminder.Occurrence.Start.After(r.LastOccurrence) {
+			r.LastOccurrence = reminder.Occurrence.Start
+		}
+
+		r.LockedUntil = time.Time{}
+		r.RemindAt = e.nextRemindAt(r)
+	}
 
-			limit--
+	for _, m := range outbox {
+		if s.outboxKeys[m.Key] {
+			continue
 		}
 
-		if limit == 0 {
+		s.lastOutboxID++
+		message := NewOutboxMessageFromApp(m)
+		message.ID = s.lastOutboxID
+		message.DateAdd = time.Now()
+		s.outbox = append(s.outbox, message)
+		s.outboxKeys[m.Key] = true
+	}
+
+	return nil
+}
+
+// ClaimOutbox returns unsent outbox messages not claimed by others in order of ids
+// and leases them till now + lease.
+func (s *Storage) ClaimOutbox(ctx context.Context, now time.Time, lease time.Duration, limit int64) (
+	[]*storage.OutboxMessage,
+	error) {
+	s.Lock()
+	defer s.Unlock()
+
+	messages := make([]*storage.OutboxMessage, 0)
+	for _, m := range s.outbox {
+		if int64(len(messages)) >= limit {
 			break
 		}
+
+		if !m.DateSent.IsZero() || m.LockedUntil.After(now) {
+			continue
+		}
+
+		m.LockedUntil = now.Add(lease)
+		message := m.ToApp()
+		messages = append(messages, &message)
 	}
 
-	return events, nil
+	return messages, nil
 }
 
-func (s *Storage) UpdateEventAsProcessed(ctx context.Context, event *storage.Event) error {
+func (s *Storage) UpdateOutboxAsSent(ctx context.Context, id int64) error {
 	s.Lock()
 	defer s.Unlock()
 
-	e, ok := s.events[event.ID]
+	for _, m := range s.outbox {
+		if m.ID == id {
+			m.DateSent = time.Now()
+			m.LockedUntil = time.Time{}
 
-	if !ok {
+			return nil
+		}
+	}
+
+	return storage.ErrOutboxMessageNotFound
+}
+
+func (s *Storage) GetAttendees(ctx context.Context, eventID string) ([]*storage.Attendee, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	attendees := make([]*storage.Attendee, 0, len(s.attendees[eventID]))
+	for _, a := range s.attendees[eventID] {
+		attendeeApp := a.ToApp()
+		attendees = append(attendees, &attendeeApp)
+	}
+
+	sort.Slice(attendees, func(i, j int) bool {
+		return attendees[i].UserID < attendees[j].UserID
+	})
+
+	return attendees, nil
+}
+
+func (s *Storage) SaveAttendee(ctx context.Context, attendee *storage.Attendee) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.events[attendee.EventID]; !ok {
 		return calendar.ErrEventNotFound
 	}
 
-	e.Processed = true
-	s.events[event.ID] = e
+	if _, ok := s.attendees[attendee.EventID]; !ok {
+		s.attendees[attendee.EventID] = make(map[string]*Attendee)
+	}
+
+	s.attendees[attendee.EventID][attendee.UserID] = NewAttendeeFromApp(attendee)
+
+	return nil
+}
+
+func (s *Storage) DeleteAttendee(ctx context.Context, eventID, userID string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.attendees[eventID][userID]; !ok {
+		return calendar.ErrAttendeeNotFound
+	}
+
+	delete(s.attendees[eventID], userID)
 
 	return nil
 }
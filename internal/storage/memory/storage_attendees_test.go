@@ -0,0 +1,75 @@
+package memorystorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/app/calendar"
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttendees(t *testing.T) {
+	begin := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	event := storage.Event{
+		ID: "event1", UserID: "user1", Start: begin, Finish: begin.Add(time.Hour), Description: "desc1", Title: "title1",
+	}
+
+	t.Run("test save, update and delete attendees", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		attendee1 := storage.Attendee{
+			EventID: event.ID, UserID: "user3", Role: storage.RoleRequired, Status: storage.StatusNeedsAction,
+		}
+		attendee2 := storage.Attendee{
+			EventID: event.ID, UserID: "user2", Email: "user2@example.com", Role: storage.RoleOptional,
+			Status: storage.StatusNeedsAction,
+		}
+		require.NoError(t, s.SaveAttendee(ctx, &attendee1))
+		require.NoError(t, s.SaveAttendee(ctx, &attendee2))
+
+		attendees, err := s.GetAttendees(ctx, event.ID)
+		require.NoError(t, err)
+		require.Equal(t, []*storage.Attendee{&attendee2, &attendee1}, attendees)
+
+		attendee1.Status = storage.StatusDeclined
+		require.NoError(t, s.SaveAttendee(ctx, &attendee1))
+
+		require.NoError(t, s.DeleteAttendee(ctx, event.ID, attendee2.UserID))
+		require.ErrorIs(t, s.DeleteAttendee(ctx, event.ID, attendee2.UserID), calendar.ErrAttendeeNotFound)
+
+		attendees, err = s.GetAttendees(ctx, event.ID)
+		require.NoError(t, err)
+		require.Equal(t, []*storage.Attendee{&attendee1}, attendees)
+	})
+
+	t.Run("test attendees of unexisting event", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+
+		err := s.SaveAttendee(ctx, &storage.Attendee{EventID: event.ID, UserID: "user2"})
+		require.ErrorIs(t, err, calendar.ErrEventNotFound)
+
+		attendees, err := s.GetAttendees(ctx, event.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(attendees))
+	})
+
+	t.Run("test attendees are deleted with event", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+		require.NoError(t, s.CreateEvent(ctx, &event))
+		require.NoError(t, s.SaveAttendee(ctx, &storage.Attendee{EventID: event.ID, UserID: "user2"}))
+
+		require.NoError(t, s.DeleteEvent(ctx, event.UserID, event.ID))
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		attendees, err := s.GetAttendees(ctx, event.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(attendees))
+	})
+}
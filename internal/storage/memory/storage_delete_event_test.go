@@ -22,11 +22,16 @@ func TestDeleteEvent(t *testing.T) {
 		after := time.Now().Add(time.Second * 10)
 
 		// adding to empty map
-		expEvent := storage.Event{ID: uuid, Start: now, Finish: after, Description: "desc1", Title: "title1"}
+		expEvent := storage.Event{
+			ID: uuid, UserID: "user1", Start: now, Finish: after, Description: "desc1", Title: "title1",
+		}
 		err := s.CreateEvent(ctx, &expEvent)
 		require.NoError(t, err)
 
-		err = s.DeleteEvent(ctx, expEvent.ID)
+		err = s.DeleteEvent(ctx, "user2", expEvent.ID)
+		require.ErrorIs(t, err, calendar.ErrEventNotFound, "event of other user is not deleted")
+
+		err = s.DeleteEvent(ctx, expEvent.UserID, expEvent.ID)
 		require.NoError(t, err)
 		_, err = s.GetEventByID(ctx, expEvent.ID)
 		require.ErrorIs(t, err, calendar.ErrEventNotFound)
@@ -44,7 +49,7 @@ func TestDeleteEvent(t *testing.T) {
 		require.NoError(t, err)
 
 		notExisting := "not found"
-		err = s.DeleteEvent(ctx, notExisting)
+		err = s.DeleteEvent(ctx, "", notExisting)
 		require.ErrorIs(t, err, calendar.ErrEventNotFound)
 		event, err := s.GetEventByID(ctx, expEvent.ID)
 		require.NoError(t, err)
@@ -0,0 +1,71 @@
+package memorystorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBusyEventsOfUsers(t *testing.T) {
+	ctx := context.Background()
+	s := memorystorage.New()
+	begin := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+
+	for _, e := range []storage.Event{
+		{ID: "single", UserID: "user1", Start: begin, Finish: begin.Add(time.Hour)},
+		{
+			ID: "series", UserID: "user1", Start: begin.Add(-time.Hour * 22), Finish: begin.Add(-time.Hour * 21),
+			RRule: "FREQ=DAILY",
+		},
+		{ID: "zero", UserID: "user1", Start: begin.Add(time.Minute), Finish: begin.Add(time.Minute)},
+		{
+			ID: "allday", UserID: "user1", Start: time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC),
+			Finish: time.Date(2021, 5, 4, 0, 0, 0, 0, time.UTC), AllDay: true,
+		},
+		{ID: "other", UserID: "user2", Start: begin, Finish: begin.Add(time.Hour)},
+		{
+			ID: "zoned", UserID: "user3", Start: time.Date(2021, 5, 4, 0, 0, 0, 0, time.UTC),
+			Finish: time.Date(2021, 5, 5, 0, 0, 0, 0, time.UTC), AllDay: true, TimeZone: "Asia/Tokyo",
+		},
+	} {
+		e := e
+		require.NoError(t, s.CreateEvent(ctx, &e))
+	}
+
+	events, err := s.GetBusyEventsOfUsers(ctx, []string{"user1"}, begin.Add(-time.Hour), begin.Add(time.Hour*3))
+	require.NoError(t, err)
+	require.Equal(t, 3, len(events))
+	require.Equal(t, "allday", events[0].ID)
+	require.Equal(t, "single", events[1].ID)
+	require.Equal(t, "series", events[2].ID)
+	require.Equal(t, begin.Add(time.Hour*2), events[2].Start)
+
+	events, err = s.GetBusyEventsOfUsers(ctx, []string{"user2"}, begin, begin.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(events))
+	require.Equal(t, "other", events[0].ID)
+
+	// touching intervals do not overlap
+	events, err = s.GetBusyEventsOfUsers(ctx, []string{"user2"}, begin.Add(time.Hour), begin.Add(time.Hour*2))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(events))
+
+	// the all-day event takes its date in its time zone
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	events, err = s.GetBusyEventsOfUsers(ctx, []string{"user3"}, begin, begin.AddDate(0, 0, 3))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(events))
+	require.True(t, events[0].Start.Equal(time.Date(2021, 5, 4, 0, 0, 0, 0, tokyo)))
+	require.True(t, events[0].Finish.Equal(time.Date(2021, 5, 5, 0, 0, 0, 0, tokyo)))
+
+	events, err = s.GetBusyEventsOfUsers(ctx, []string{"user3"}, time.Date(2021, 5, 4, 15, 0, 0, 0, time.UTC),
+		time.Date(2021, 5, 4, 18, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(events), "the date is over in Tokyo")
+}
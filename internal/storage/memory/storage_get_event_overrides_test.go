@@ -0,0 +1,72 @@
+package memorystorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/app/calendar"
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEventOverrides(t *testing.T) {
+	begin := time.Date(2020, 10, 12, 9, 0, 0, 0, time.UTC)
+	after := begin.Add(time.Minute * 15)
+
+	series := storage.Event{
+		ID: "series", Start: begin, Finish: after, Description: "desc1", Title: "title1", RRule: "FREQ=DAILY",
+		ExDates: []time.Time{begin.AddDate(0, 0, 2), begin.AddDate(0, 0, 1)},
+	}
+	override1 := storage.Event{
+		ID: "override1", Start: begin.Add(time.Hour), Finish: after.Add(time.Hour), Description: "desc1",
+		Title: "title1", RecurringEventID: series.ID, RecurrenceID: begin.AddDate(0, 0, 2),
+	}
+	override2 := storage.Event{
+		ID: "override2", Start: begin.Add(time.Hour), Finish: after.Add(time.Hour), Description: "desc1",
+		Title: "title1", RecurringEventID: series.ID, RecurrenceID: begin.AddDate(0, 0, 1),
+	}
+	other := storage.Event{ID: "other", Start: begin, Finish: after, Description: "desc2", Title: "title2"}
+
+	t.Run("test get overrides sorted by recurrence id", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+
+		for _, e := range []storage.Event{series, override1, override2, other} {
+			e := e
+			require.NoError(t, s.CreateEvent(ctx, &e))
+		}
+
+		overrides, err := s.GetEventOverrides(ctx, series.ID)
+		require.NoError(t, err)
+		require.Equal(t, []*storage.Event{&override2, &override1}, overrides)
+
+		overrides, err = s.GetEventOverrides(ctx, other.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(overrides))
+	})
+
+	t.Run("test overrides are deleted with series", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+
+		for _, e := range []storage.Event{series, override1, override2, other} {
+			e := e
+			require.NoError(t, s.CreateEvent(ctx, &e))
+		}
+
+		require.NoError(t, s.DeleteEvent(ctx, series.UserID, series.ID))
+
+		overrides, err := s.GetEventOverrides(ctx, series.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(overrides))
+
+		_, err = s.GetEventByID(ctx, override1.ID)
+		require.ErrorIs(t, err, calendar.ErrEventNotFound)
+
+		event, err := s.GetEventByID(ctx, other.ID)
+		require.NoError(t, err)
+		require.Equal(t, &other, event)
+	})
+}
@@ -19,7 +19,7 @@ func TestGetEventsByDaySortedEmpty(t *testing.T) {
 
 		botd, err := time.Parse("2006-01-02", now.Format("2006-01-02"))
 		require.NoError(t, err)
-		events, err := s.GetEventsByDaySorted(ctx, botd, 1, 0)
+		events, err := s.GetEventsByDaySorted(ctx, "user1", botd, 1, 0)
 		require.NoError(t, err)
 		require.Equal(t, 0, len(events))
 	})
@@ -29,16 +29,18 @@ func TestGetEventsByDaySortedEmpty(t *testing.T) {
 		s := memorystorage.New()
 
 		begin := time.Date(2020, 10, 11, 15, 16, 0, 0, time.UTC)
-		after := begin.AddDate(0, 0, 2)
+		after := begin.Add(time.Hour)
 
-		event := storage.Event{ID: "test", Start: begin, Finish: after, Description: "desc1", Title: "title1"}
+		event := storage.Event{
+			ID: "test", UserID: "user1", Start: begin, Finish: after, Description: "desc1", Title: "title1",
+		}
 		err := s.CreateEvent(ctx, &event)
 		require.NoError(t, err)
 
 		botnd, err := time.Parse("2006-01-02", begin.AddDate(0, 0, 1).
 			Format("2006-01-02"))
 		require.NoError(t, err)
-		events, err := s.GetEventsByDaySorted(ctx, botnd, 1, 0)
+		events, err := s.GetEventsByDaySorted(ctx, "user1", botnd, 1, 0)
 		require.NoError(t, err)
 		require.Equal(t, 0, len(events))
 	})
@@ -56,6 +58,7 @@ func TestGetEventsByDaySortedValid(t *testing.T) {
 			createEvents: []*storage.Event{
 				{
 					ID:          "event1",
+					UserID:      "user1",
 					Start:       time.Date(2020, 10, 11, 15, 16, 0, 0, time.UTC),
 					Finish:      time.Date(2020, 10, 13, 15, 16, 0, 0, time.UTC),
 					Description: "desc1",
@@ -63,6 +66,7 @@ func TestGetEventsByDaySortedValid(t *testing.T) {
 				},
 				{
 					ID:          "event2",
+					UserID:      "user1",
 					Start:       time.Date(2020, 10, 11, 23, 16, 0, 0, time.UTC),
 					Finish:      time.Date(2020, 10, 13, 15, 16, 0, 0, time.UTC),
 					Description: "desc2",
@@ -70,6 +74,7 @@ func TestGetEventsByDaySortedValid(t *testing.T) {
 				},
 				{
 					ID:          "event3",
+					UserID:      "user1",
 					Start:       time.Date(2020, 10, 12, 23, 16, 0, 0, time.UTC),
 					Finish:      time.Date(2020, 10, 13, 15, 16, 0, 0, time.UTC),
 					Description: "desc3",
@@ -82,6 +87,7 @@ func TestGetEventsByDaySortedValid(t *testing.T) {
 			expEvents: []*storage.Event{
 				{
 					ID:          "event1",
+					UserID:      "user1",
 					Start:       time.Date(2020, 10, 11, 15, 16, 0, 0, time.UTC),
 					Finish:      time.Date(2020, 10, 13, 15, 16, 0, 0, time.UTC),
 					Description: "desc1",
@@ -93,6 +99,7 @@ func TestGetEventsByDaySortedValid(t *testing.T) {
 			createEvents: []*storage.Event{
 				{
 					ID:          "event1",
+					UserID:      "user1",
 					Start:       time.Date(2020, 10, 11, 15, 16, 0, 0, time.UTC),
 					Finish:      time.Date(2020, 10, 13, 15, 16, 0, 0, time.UTC),
 					Description: "desc1",
@@ -100,6 +107,7 @@ func TestGetEventsByDaySortedValid(t *testing.T) {
 				},
 				{
 					ID:          "event2",
+					UserID:      "user1",
 					Start:       time.Date(2020, 10, 11, 22, 16, 0, 0, time.UTC),
 					Finish:      time.Date(2020, 10, 13, 15, 16, 0, 0, time.UTC),
 					Description: "desc2",
@@ -107,6 +115,7 @@ func TestGetEventsByDaySortedValid(t *testing.T) {
 				},
 				{
 					ID:          "event3",
+					UserID:      "user1",
 					Start:       time.Date(2020, 10, 11, 23, 16, 0, 0, time.UTC),
 					Finish:      time.Date(2020, 10, 13, 15, 16, 0, 0, time.UTC),
 					Description: "desc3",
@@ -114,6 +123,7 @@ func TestGetEventsByDaySortedValid(t *testing.T) {
 				},
 				{
 					ID:          "event4",
+					UserID:      "user1",
 					Start:       time.Date(2020, 10, 11, 16, 16, 0, 0, time.UTC),
 					Finish:      time.Date(2020, 10, 13, 15, 16, 0, 0, time.UTC),
 					Description: "desc4",
@@ -126,6 +136,7 @@ func TestGetEventsByDaySortedValid(t *testing.T) {
 			expEvents: []*storage.Event{
 				{
 					ID:          "event2",
+					UserID:      "user1",
 					Start:       time.Date(2020, 10, 11, 22, 16, 0, 0, time.UTC),
 					Finish:      time.Date(2020, 10, 13, 15, 16, 0, 0, time.UTC),
 					Description: "desc2",
@@ -133,10 +144,143 @@ func TestGetEventsByDaySortedValid(t *testing.T) {
 				},
 			},
 		},
+		"get occurrences of recurring events": {
+			createEvents: []*storage.Event{
+				{
+					ID:          "event1",
+					UserID:      "user1",
+					Start:       time.Date(2020, 10, 12, 9, 0, 0, 0, time.UTC),
+					Finish:      time.Date(2020, 10, 12, 9, 15, 0, 0, time.UTC),
+					Description: "desc1",
+					Title:       "title1",
+					RRule:       "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+				},
+				{
+					ID:          "event2",
+					UserID:      "user1",
+					Start:       time.Date(2020, 10, 1, 8, 0, 0, 0, time.UTC),
+					Finish:      time.Date(2020, 10, 1, 9, 0, 0, 0, time.UTC),
+					Description: "desc2",
+					Title:       "title2",
+					RRule:       "FREQ=WEEKLY;BYDAY=TH",
+				},
+				{
+					ID:          "event3",
+					UserID:      "user1",
+					Start:       time.Date(2020, 10, 15, 10, 0, 0, 0, time.UTC),
+					Finish:      time.Date(2020, 10, 15, 11, 0, 0, 0, time.UTC),
+					Description: "desc3",
+					Title:       "title3",
+				},
+				{
+					ID:          "event4",
+					UserID:      "user1",
+					Start:       time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC),
+					Finish:      time.Date(2020, 10, 1, 13, 0, 0, 0, time.UTC),
+					Description: "desc4",
+					Title:       "title4",
+					RRule:       "FREQ=WEEKLY;COUNT=2",
+				},
+			},
+			dateSearch: time.Date(2020, 10, 15, 0o0, 0o0, 0, 0, time.UTC),
+			limit:      10,
+			offset:     0,
+			expEvents: []*storage.Event{
+				{
+					ID:               "event2",
+					UserID:           "user1",
+					Start:            time.Date(2020, 10, 15, 8, 0, 0, 0, time.UTC),
+					Finish:           time.Date(2020, 10, 15, 9, 0, 0, 0, time.UTC),
+					Description:      "desc2",
+					Title:            "title2",
+					RRule:            "FREQ=WEEKLY;BYDAY=TH",
+					RecurringEventID: "event2",
+					RecurrenceID:     time.Date(2020, 10, 15, 8, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:               "event1",
+					UserID:           "user1",
+					Start:            time.Date(2020, 10, 15, 9, 0, 0, 0, time.UTC),
+					Finish:           time.Date(2020, 10, 15, 9, 15, 0, 0, time.UTC),
+					Description:      "desc1",
+					Title:            "title1",
+					RRule:            "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+					RecurringEventID: "event1",
+					RecurrenceID:     time.Date(2020, 10, 15, 9, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:          "event3",
+					UserID:      "user1",
+					Start:       time.Date(2020, 10, 15, 10, 0, 0, 0, time.UTC),
+					Finish:      time.Date(2020, 10, 15, 11, 0, 0, 0, time.UTC),
+					Description: "desc3",
+					Title:       "title3",
+				},
+			},
+		},
+		"get recurring event with cancelled and overridden occurrences": {
+			createEvents: []*storage.Event{
+				{
+					ID:          "event1",
+					UserID:      "user1",
+					Start:       time.Date(2020, 10, 12, 9, 0, 0, 0, time.UTC),
+					Finish:      time.Date(2020, 10, 12, 9, 15, 0, 0, time.UTC),
+					Description: "desc1",
+					Title:       "title1",
+					RRule:       "FREQ=DAILY",
+					ExDates: []time.Time{
+						time.Date(2020, 10, 14, 9, 0, 0, 0, time.UTC),
+						time.Date(2020, 10, 15, 9, 0, 0, 0, time.UTC),
+					},
+				},
+				{
+					ID:               "event2",
+					UserID:           "user1",
+					Start:            time.Date(2020, 10, 15, 11, 0, 0, 0, time.UTC),
+					Finish:           time.Date(2020, 10, 15, 11, 15, 0, 0, time.UTC),
+					Description:      "desc1",
+					Title:            "title1 moved",
+					RecurringEventID: "event1",
+					RecurrenceID:     time.Date(2020, 10, 14, 9, 0, 0, 0, time.UTC),
+				},
+			},
+			dateSearch: time.Date(2020, 10, 15, 0o0, 0o0, 0, 0, time.UTC),
+			limit:      10,
+			offset:     0,
+			expEvents: []*storage.Event{
+				{
+					ID:               "event2",
+					UserID:           "user1",
+					Start:            time.Date(2020, 10, 15, 11, 0, 0, 0, time.UTC),
+					Finish:           time.Date(2020, 10, 15, 11, 15, 0, 0, time.UTC),
+					Description:      "desc1",
+					Title:            "title1 moved",
+					RecurringEventID: "event1",
+					RecurrenceID:     time.Date(2020, 10, 14, 9, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		"no events of other users": {
+			createEvents: []*storage.Event{
+				{
+					ID:          "event1",
+					UserID:      "user2",
+					Start:       time.Date(2020, 10, 11, 15, 16, 0, 0, time.UTC),
+					Finish:      time.Date(2020, 10, 13, 15, 16, 0, 0, time.UTC),
+					Description: "desc1",
+					Title:       "title1",
+				},
+			},
+			dateSearch: time.Date(2020, 10, 11, 0o0, 0o0, 0, 0, time.UTC),
+			limit:      1,
+			offset:     0,
+			expEvents:  []*storage.Event{},
+		},
 		"no events with limit 1 and offset 1": {
 			createEvents: []*storage.Event{
 				{
 					ID:          "event1",
+					UserID:      "user1",
 					Start:       time.Date(2020, 10, 11, 15, 16, 0, 0, time.UTC),
 					Finish:      time.Date(2020, 10, 13, 15, 16, 0, 0, time.UTC),
 					Description: "desc1",
@@ -160,9 +304,130 @@ func TestGetEventsByDaySortedValid(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			events, err := s.GetEventsByDaySorted(ctx, data.dateSearch, data.limit, data.offset)
+			events, err := s.GetEventsByDaySorted(ctx, "user1", data.dateSearch, data.limit, data.offset)
 			require.NoError(t, err)
 			require.Equal(t, data.expEvents, events)
 		})
 	}
 }
+
+func TestGetEventsByDaySortedTimeZones(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	t.Run("test recurring event keeps wall clock time after DST transition", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+
+		// CEST, DST ends on 2020-10-25
+		event := storage.Event{
+			ID: "event1", UserID: "user1", Description: "desc1", Title: "title1",
+			Start:    time.Date(2020, 10, 22, 9, 0, 0, 0, berlin),
+			Finish:   time.Date(2020, 10, 22, 9, 30, 0, 0, berlin),
+			TimeZone: "Europe/Berlin",
+			RRule:    "FREQ=WEEKLY",
+		}
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		events, err := s.GetEventsByDaySorted(ctx, "user1", time.Date(2020, 10, 29, 0, 0, 0, 0, berlin), 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(events))
+		require.Equal(t, time.Date(2020, 10, 29, 8, 0, 0, 0, time.UTC), events[0].Start.UTC())
+		require.Equal(t, time.Date(2020, 10, 29, 8, 30, 0, 0, time.UTC), events[0].Finish.UTC())
+	})
+
+	t.Run("test day is taken in time zone of query", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+
+		// 2020-11-01 23:30 in New York, the day lasts 25 hours there because of DST end
+		event := storage.Event{
+			ID: "event1", UserID: "user1", Description: "desc1", Title: "title1",
+			Start:  time.Date(2020, 11, 2, 4, 30, 0, 0, time.UTC),
+			Finish: time.Date(2020, 11, 2, 5, 0, 0, 0, time.UTC),
+		}
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		events, err := s.GetEventsByDaySorted(ctx, "user1", time.Date(2020, 11, 1, 0, 0, 0, 0, newYork), 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(events))
+
+		events, err = s.GetEventsByDaySorted(ctx, "user1", time.Date(2020, 11, 2, 0, 0, 0, 0, newYork), 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(events))
+
+		events, err = s.GetEventsByDaySorted(ctx, "user1", time.Date(2020, 11, 2, 0, 0, 0, 0, time.UTC), 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(events))
+	})
+}
+
+func TestGetEventsByDaySortedAllDay(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	t.Run("test multi-day event is returned on every day it spans", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+
+		event := storage.Event{
+			ID: "event1", UserID: "user1", Description: "desc1", Title: "title1",
+			Start:  time.Date(2020, 10, 11, 22, 0, 0, 0, time.UTC),
+			Finish: time.Date(2020, 10, 13, 2, 0, 0, 0, time.UTC),
+		}
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		for day, exp := range map[int]int{10: 0, 11: 1, 12: 1, 13: 1, 14: 0} {
+			events, err := s.GetEventsByDaySorted(ctx, "user1", time.Date(2020, 10, day, 0, 0, 0, 0, time.UTC), 10, 0)
+			require.NoError(t, err)
+			require.Equal(t, exp, len(events), "day %d", day)
+		}
+	})
+
+	t.Run("test all-day event keeps its dates in every time zone", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+
+		event := storage.Event{
+			ID: "event1", UserID: "user1", Description: "desc1", Title: "title1",
+			Start:  time.Date(2020, 10, 11, 0, 0, 0, 0, time.UTC),
+			Finish: time.Date(2020, 10, 13, 0, 0, 0, 0, time.UTC),
+			AllDay: true,
+		}
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		for _, loc := range []*time.Location{time.UTC, newYork} {
+			for day, exp := range map[int]int{10: 0, 11: 1, 12: 1, 13: 0} {
+				events, err := s.GetEventsByDaySorted(ctx, "user1", time.Date(2020, 10, day, 0, 0, 0, 0, loc), 10, 0)
+				require.NoError(t, err)
+				require.Equal(t, exp, len(events), "day %d in %s", day, loc)
+			}
+		}
+	})
+
+	t.Run("test all-day events go first", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+
+		timed := storage.Event{
+			ID: "event1", UserID: "user1", Description: "desc1", Title: "title1",
+			Start:  time.Date(2020, 10, 11, 0, 0, 0, 0, time.UTC),
+			Finish: time.Date(2020, 10, 11, 1, 0, 0, 0, time.UTC),
+		}
+		allDay := storage.Event{
+			ID: "event2", UserID: "user1", Description: "desc2", Title: "title2",
+			Start:  time.Date(2020, 10, 11, 0, 0, 0, 0, time.UTC),
+			Finish: time.Date(2020, 10, 12, 0, 0, 0, 0, time.UTC),
+			AllDay: true,
+		}
+		require.NoError(t, s.CreateEvent(ctx, &timed))
+		require.NoError(t, s.CreateEvent(ctx, &allDay))
+
+		events, err := s.GetEventsByDaySorted(ctx, "user1", time.Date(2020, 10, 11, 0, 0, 0, 0, time.UTC), 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, []*storage.Event{&allDay, &timed}, events)
+	})
+}
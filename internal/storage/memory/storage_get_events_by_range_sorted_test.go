@@ -0,0 +1,79 @@
+package memorystorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEventsByRangeSorted(t *testing.T) {
+	begin := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+
+	t.Run("test pages with recurring events", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+
+		require.NoError(t, s.CreateEvent(ctx, &storage.Event{
+			ID: "event1", UserID: "user1", Description: "desc1", Title: "title1",
+			Start: begin, Finish: begin.Add(time.Hour), RRule: "FREQ=DAILY;COUNT=3",
+		}))
+		require.NoError(t, s.CreateEvent(ctx, &storage.Event{
+			ID: "event2", UserID: "user1", Description: "desc2", Title: "title2",
+			Start: begin.Add(time.Hour * 30), Finish: begin.Add(time.Hour * 31),
+		}))
+		require.NoError(t, s.CreateEvent(ctx, &storage.Event{
+			ID: "event3", UserID: "user2", Description: "desc3", Title: "title3",
+			Start: begin, Finish: begin.Add(time.Hour),
+		}))
+
+		from, to := begin.Add(-time.Hour), begin.AddDate(0, 0, 7)
+
+		events, err := s.GetEventsByRangeSorted(ctx, "user1", from, to, nil, 2)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(events))
+		require.Equal(t, begin, events[0].Start)
+		require.Equal(t, begin.AddDate(0, 0, 1), events[1].Start)
+
+		events, err = s.GetEventsByRangeSorted(ctx, "user1", from, to, storage.CursorOf(events[1]), 2)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(events))
+		require.Equal(t, "event2", events[0].ID)
+		require.Equal(t, begin.AddDate(0, 0, 2), events[1].Start)
+
+		events, err = s.GetEventsByRangeSorted(ctx, "user1", from, to, storage.CursorOf(events[1]), 2)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(events))
+	})
+
+	t.Run("test page is stable when events are inserted before cursor", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+
+		for i, id := range []string{"event1", "event2", "event3"} {
+			require.NoError(t, s.CreateEvent(ctx, &storage.Event{
+				ID: id, UserID: "user1", Description: "desc", Title: "title",
+				Start: begin.Add(time.Hour * time.Duration(i)), Finish: begin.Add(time.Hour * time.Duration(i+1)),
+			}))
+		}
+
+		from, to := begin, begin.AddDate(0, 0, 1)
+
+		events, err := s.GetEventsByRangeSorted(ctx, "user1", from, to, nil, 2)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(events))
+
+		require.NoError(t, s.CreateEvent(ctx, &storage.Event{
+			ID: "event0", UserID: "user1", Description: "desc", Title: "title",
+			Start: begin, Finish: begin.Add(time.Minute),
+		}))
+
+		events, err = s.GetEventsByRangeSorted(ctx, "user1", from, to, storage.CursorOf(events[1]), 2)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(events))
+		require.Equal(t, "event3", events[0].ID)
+	})
+}
@@ -0,0 +1,73 @@
+package memorystorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutbox(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("messages are stored with processed reminder once per key", func(t *testing.T) {
+		s := memorystorage.New()
+
+		event := storage.Event{ID: "event1", Start: now, Finish: now.Add(time.Hour), Reminders: []time.Duration{0}}
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		due, _, err := s.ClaimDueReminders(ctx, now, 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+
+		outbox := []*storage.OutboxMessage{
+			{Key: "key1", Payload: []byte(`{"UserID":"user1"}`)},
+			{Key: "key2", Payload: []byte(`{"UserID":"user2"}`)},
+		}
+		require.NoError(t, s.UpdateReminderAsProcessed(ctx, due[0], outbox))
+		require.NoError(t, s.UpdateReminderAsProcessed(ctx, due[0], outbox[:1]))
+
+		messages, err := s.ClaimOutbox(ctx, now, time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, messages, 2)
+		require.Equal(t, "key1", messages[0].Key)
+		require.Equal(t, []byte(`{"UserID":"user1"}`), messages[0].Payload)
+		require.Equal(t, "key2", messages[1].Key)
+		require.Less(t, messages[0].ID, messages[1].ID)
+	})
+
+	t.Run("claimed messages are hidden till the end of the lease, sent ones are not claimed", func(t *testing.T) {
+		s := memorystorage.New()
+
+		event := storage.Event{ID: "event1", Start: now, Finish: now.Add(time.Hour), Reminders: []time.Duration{0}}
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		due, _, err := s.ClaimDueReminders(ctx, now, 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.NoError(t, s.UpdateReminderAsProcessed(ctx, due[0], []*storage.OutboxMessage{
+			{Key: "key1", Payload: []byte(`{}`)}, {Key: "key2", Payload: []byte(`{}`)},
+		}))
+
+		messages, err := s.ClaimOutbox(ctx, now, time.Minute, 1)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, "key1", messages[0].Key)
+
+		messages, err = s.ClaimOutbox(ctx, now, time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, "key2", messages[0].Key)
+		require.NoError(t, s.UpdateOutboxAsSent(ctx, messages[0].ID))
+
+		messages, err = s.ClaimOutbox(ctx, now.Add(time.Minute), time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, "key1", messages[0].Key)
+
+		require.ErrorIs(t, s.UpdateOutboxAsSent(ctx, 100), storage.ErrOutboxMessageNotFound)
+	})
+}
@@ -0,0 +1,114 @@
+package memorystorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeEvents(t *testing.T) {
+	ctx := context.Background()
+	before := time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC)
+
+	create := func(t *testing.T, s *memorystorage.Storage, id string, start time.Time, rrule string) {
+		t.Helper()
+
+		event := storage.Event{
+			ID: id, Start: start, Finish: start.Add(time.Hour), RRule: rrule, Reminders: storage.DefaultReminders,
+		}
+		require.NoError(t, s.CreateEvent(ctx, &event))
+	}
+
+	newStorage := func(t *testing.T) *memorystorage.Storage {
+		t.Helper()
+
+		s := memorystorage.New()
+		create(t, s, "old1", before.Add(-48*time.Hour), "")
+		create(t, s, "old2", before.Add(-72*time.Hour), "")
+		create(t, s, "finishing", before.Add(-30*time.Minute), "")
+		create(t, s, "ended series", before.AddDate(0, 0, -10), "FREQ=DAILY;COUNT=3")
+		create(t, s, "endless series", before.AddDate(0, 0, -10), "FREQ=DAILY")
+		create(t, s, "running series", before.AddDate(0, 0, -10), "FREQ=DAILY;UNTIL=20210510T000000Z")
+
+		return s
+	}
+
+	t.Run("old events are purged in batches", func(t *testing.T) {
+		s := newStorage(t)
+
+		purged, err := s.PurgeEvents(ctx, before, false, 2)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), purged)
+
+		_, err = s.GetEventByID(ctx, "old2")
+		require.Error(t, err, "the oldest events go first")
+
+		purged, err = s.PurgeEvents(ctx, before, false, 2)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), purged)
+
+		_, err = s.GetEventByID(ctx, "ended series")
+		require.Error(t, err)
+
+		for _, id := range []string{"finishing", "endless series", "running series"} {
+			_, err = s.GetEventByID(ctx, id)
+			require.NoError(t, err, id)
+		}
+
+		archived, err := s.ArchivedEvents(ctx)
+		require.NoError(t, err)
+		require.Empty(t, archived)
+	})
+
+	t.Run("purged events are archived", func(t *testing.T) {
+		s := newStorage(t)
+		require.NoError(t, s.SaveAttendee(ctx, &storage.Attendee{
+			EventID: "old1", UserID: "user2", Role: storage.RoleRequired, Status: storage.StatusAccepted,
+		}))
+
+		purged, err := s.PurgeEvents(ctx, before, true, 10)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), purged)
+
+		archived, err := s.ArchivedEvents(ctx)
+		require.NoError(t, err)
+		require.Len(t, archived, 3)
+		require.Equal(t, "ended series", archived[0].ID)
+		require.Equal(t, storage.DefaultReminders, archived[0].Reminders, "reminders are archived with events")
+
+		attendees, err := s.ArchivedAttendees(ctx, "old1")
+		require.NoError(t, err)
+		require.Len(t, attendees, 1)
+		require.Equal(t, "user2", attendees[0].UserID)
+
+		attendees, err = s.GetAttendees(ctx, "old1")
+		require.NoError(t, err)
+		require.Empty(t, attendees)
+	})
+
+	t.Run("series is purged after its overrides", func(t *testing.T) {
+		s := memorystorage.New()
+		create(t, s, "series", before.AddDate(0, 0, -10), "FREQ=DAILY;COUNT=3")
+
+		override := storage.Event{
+			ID: "override", Start: before.AddDate(0, 0, -9), Finish: before.AddDate(0, 0, -9).Add(time.Hour),
+			RecurringEventID: "series", RecurrenceID: before.AddDate(0, 0, -9),
+		}
+		require.NoError(t, s.CreateEvent(ctx, &override))
+
+		purged, err := s.PurgeEvents(ctx, before, false, 10)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), purged)
+
+		_, err = s.GetEventByID(ctx, "series")
+		require.NoError(t, err)
+
+		purged, err = s.PurgeEvents(ctx, before, false, 10)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), purged)
+	})
+}
@@ -0,0 +1,184 @@
+package memorystorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/app/calendar"
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDueReminders(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Minute)
+
+	t.Run("reminders are due before the start", func(t *testing.T) {
+		s := memorystorage.New()
+
+		soon := storage.Event{
+			ID: "soon", Start: now.Add(15 * time.Minute), Finish: now.Add(time.Hour),
+			Reminders: []time.Duration{15 * time.Minute, 24 * time.Hour},
+		}
+		require.NoError(t, s.CreateEvent(ctx, &soon))
+
+		later := storage.Event{
+			ID: "later", Start: now.Add(24 * time.Hour), Finish: now.Add(25 * time.Hour),
+			Reminders: []time.Duration{0, 24 * time.Hour},
+		}
+		require.NoError(t, s.CreateEvent(ctx, &later))
+
+		silent := storage.Event{ID: "silent", Start: now, Finish: now.Add(time.Hour)}
+		require.NoError(t, s.CreateEvent(ctx, &silent))
+
+		due, _, err := s.ClaimDueReminders(ctx, now, 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 2)
+		require.Equal(t, "later", due[0].EventID)
+		require.Equal(t, 24*time.Hour, due[0].Offset)
+		require.Equal(t, "soon", due[1].EventID)
+		require.Equal(t, 15*time.Minute, due[1].Offset)
+
+		due, _, err = s.ClaimDueReminders(ctx, now, 0, time.Hour, 1)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+	})
+
+	t.Run("processed reminders are not due", func(t *testing.T) {
+		s := memorystorage.New()
+
+		event := storage.Event{
+			ID: "event1", Start: now.Add(15 * time.Minute), Finish: now.Add(time.Hour),
+			Reminders: []time.Duration{15 * time.Minute},
+		}
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		due, _, err := s.ClaimDueReminders(ctx, now, 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+
+		require.NoError(t, s.UpdateReminderAsProcessed(ctx, due[0], nil))
+
+		due, _, err = s.ClaimDueReminders(ctx, now, 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+
+		stored, err := s.GetEventByID(ctx, event.ID)
+		require.NoError(t, err)
+		require.True(t, stored.Processed)
+
+		// moved event is reminded again, reminders with kept offsets are reminded for the new start only
+		event.Start = now.Add(30 * time.Minute)
+		event.Reminders = []time.Duration{15 * time.Minute, 30 * time.Minute}
+		require.NoError(t, s.UpdateEvent(ctx, event.ID, &event))
+
+		stored, err = s.GetEventByID(ctx, event.ID)
+		require.NoError(t, err)
+		require.False(t, stored.Processed)
+		require.Equal(t, event.Reminders, stored.Reminders)
+
+		due, _, err = s.ClaimDueReminders(ctx, now, 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		require.Equal(t, 30*time.Minute, due[0].Offset)
+	})
+
+	t.Run("recurring event is reminded about every occurrence", func(t *testing.T) {
+		s := memorystorage.New()
+
+		event := storage.Event{
+			ID: "event1", Start: now.Add(-48 * time.Hour), Finish: now.Add(-47 * time.Hour), RRule: "FREQ=DAILY",
+			Reminders: []time.Duration{0},
+		}
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		due, _, err := s.ClaimDueReminders(ctx, now, 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		require.Equal(t, now, due[0].Occurrence.Start)
+
+		require.NoError(t, s.UpdateReminderAsProcessed(ctx, due[0], nil))
+
+		due, _, err = s.ClaimDueReminders(ctx, now, 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+
+		due, _, err = s.ClaimDueReminders(ctx, now.Add(24*time.Hour), 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		require.Equal(t, now.Add(24*time.Hour), due[0].Occurrence.Start)
+
+		stored, err := s.GetEventByID(ctx, event.ID)
+		require.NoError(t, err)
+		require.False(t, stored.Processed)
+	})
+
+	t.Run("late reminders are skipped and counted once", func(t *testing.T) {
+		s := memorystorage.New()
+
+		event := storage.Event{
+			ID: "event1", Start: now.Add(-72 * time.Hour), Finish: now.Add(-71 * time.Hour), RRule: "FREQ=DAILY",
+			Reminders: []time.Duration{0},
+		}
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		due, skipped, err := s.ClaimDueReminders(ctx, now.Add(2*time.Hour), 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+		require.Equal(t, int64(1), skipped)
+
+		due, skipped, err = s.ClaimDueReminders(ctx, now.Add(2*time.Hour), 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+		require.Zero(t, skipped, "the reminder is not checked till the next occurrence")
+
+		due, skipped, err = s.ClaimDueReminders(ctx, now.Add(24*time.Hour), 0, time.Hour, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		require.Zero(t, skipped)
+
+		due, skipped, err = s.ClaimDueReminders(ctx, now.Add(24*time.Hour), 0, 3*time.Hour, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		require.Zero(t, skipped)
+	})
+
+	t.Run("claimed reminders are hidden till the end of the lease", func(t *testing.T) {
+		s := memorystorage.New()
+
+		event := storage.Event{
+			ID: "event1", Start: now, Finish: now.Add(time.Hour), Reminders: []time.Duration{0},
+		}
+		require.NoError(t, s.CreateEvent(ctx, &event))
+
+		due, _, err := s.ClaimDueReminders(ctx, now, 5*time.Minute, time.Hour, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+
+		due, _, err = s.ClaimDueReminders(ctx, now.Add(4*time.Minute), 5*time.Minute, time.Hour, 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+
+		due, _, err = s.ClaimDueReminders(ctx, now.Add(5*time.Minute), 5*time.Minute, time.Hour, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+
+		require.NoError(t, s.UpdateReminderAsProcessed(ctx, due[0], nil))
+
+		due, _, err = s.ClaimDueReminders(ctx, now.Add(20*time.Minute), 5*time.Minute, time.Hour, 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+	})
+
+	t.Run("update reminder of unexisting event", func(t *testing.T) {
+		s := memorystorage.New()
+
+		err := s.UpdateReminderAsProcessed(ctx, &storage.DueReminder{
+			Reminder:   storage.Reminder{EventID: "test"},
+			Occurrence: &storage.Event{ID: "test", Start: now},
+		}, nil)
+		require.ErrorIs(t, err, calendar.ErrEventNotFound)
+	})
+}
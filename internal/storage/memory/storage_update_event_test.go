@@ -59,4 +59,21 @@ func TestUpdateEvent(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, &expEvent, event)
 	})
+	t.Run("test update event of another user", func(t *testing.T) {
+		ctx := context.Background()
+		s := memorystorage.New()
+
+		now := time.Now()
+		expEvent := storage.Event{ID: "test", UserID: "user1", Start: now, Finish: now.Add(time.Minute), Title: "title1"}
+		require.NoError(t, s.CreateEvent(ctx, &expEvent))
+
+		eventU := expEvent
+		eventU.UserID = "user2"
+		eventU.Title = "title_updated"
+		require.ErrorIs(t, s.UpdateEvent(ctx, expEvent.ID, &eventU), calendar.ErrEventNotFound)
+
+		event, err := s.GetEventByID(ctx, expEvent.ID)
+		require.NoError(t, err)
+		require.Equal(t, &expEvent, event)
+	})
 }
@@ -0,0 +1,18 @@
+package storage
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrOutboxMessageNotFound = errors.New("outbox message not found")
+
+// OutboxMessage is a message stored along with the change which produced it and published to the broker later,
+// Key identifies the message so duplicates are not stored and consumers can drop redelivered ones.
+type OutboxMessage struct {
+	ID        int64
+	Key       string
+	Payload   []byte
+	CreatedAt time.Time
+	SentAt    time.Time
+}
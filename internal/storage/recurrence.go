@@ -0,0 +1,285 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+// frequencies supported in RRULE.
+const (
+	FreqDaily   Frequency = "DAILY"
+	FreqWeekly  Frequency = "WEEKLY"
+	FreqMonthly Frequency = "MONTHLY"
+	FreqYearly  Frequency = "YEARLY"
+)
+
+var ErrInvalidRecurrenceRule = errors.New("invalid recurrence rule")
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// RecurrenceRule is a subset of RFC 5545 RRULE: FREQ, INTERVAL, BYDAY, COUNT and UNTIL.
+type RecurrenceRule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []time.Weekday
+	Count    int
+	Until    time.Time
+}
+
+func ParseRecurrenceRule(rule string) (*RecurrenceRule, error) {
+	r := RecurrenceRule{Interval: 1}
+
+	for _, part := range strings.Split(strings.TrimPrefix(rule, "RRULE:"), ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid part %q: %w", part, ErrInvalidRecurrenceRule)
+		}
+
+		var err error
+		switch strings.ToUpper(kv[0]) {
+		case "FREQ":
+			r.Freq = Frequency(strings.ToUpper(kv[1]))
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(kv[1])
+			if err == nil && r.Interval < 1 {
+				err = errors.New("interval should be positive")
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(kv[1])
+			if err == nil && r.Count < 1 {
+				err = errors.New("count should be positive")
+			}
+		case "UNTIL":
+			r.Until, err = parseUntil(kv[1])
+		case "BYDAY":
+			r.ByDay, err = parseByDay(kv[1])
+		default:
+			err = errors.New("unsupported part")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid part %q: %v, %w", part, err, ErrInvalidRecurrenceRule)
+		}
+	}
+
+	switch r.Freq {
+	case FreqDaily, FreqWeekly, FreqMonthly:
+	case FreqYearly:
+		if len(r.ByDay) > 0 {
+			return nil, fmt.Errorf("BYDAY with FREQ=YEARLY is not supported: %w", ErrInvalidRecurrenceRule)
+		}
+	default:
+		return nil, fmt.Errorf("invalid freq %q: %w", r.Freq, ErrInvalidRecurrenceRule)
+	}
+
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, fmt.Errorf("COUNT and UNTIL are mutually exclusive: %w", ErrInvalidRecurrenceRule)
+	}
+
+	return &r, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse("20060102", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// date-only UNTIL is inclusive, so the whole day is allowed
+	return t.AddDate(0, 0, 1).Add(-time.Second), nil
+}
+
+func parseByDay(value string) ([]time.Weekday, error) {
+	days := make([]time.Weekday, 0, len(weekdays))
+	seen := make(map[time.Weekday]bool, len(weekdays))
+
+	for _, d := range strings.Split(value, ",") {
+		wd, ok := weekdays[strings.ToUpper(d)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported weekday %q", d)
+		}
+
+		if !seen[wd] {
+			seen[wd] = true
+			days = append(days, wd)
+		}
+	}
+
+	// RFC 5545 weeks start on monday by default
+	sort.Slice(days, func(i, j int) bool {
+		return (days[i]+6)%7 < (days[j]+6)%7
+	})
+
+	return days, nil
+}
+
+func (r *RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, wd := range r.ByDay {
+			for name, v := range weekdays {
+				if v == wd {
+					days = append(days, name)
+				}
+			}
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Between returns starts of occurrences in [from, to) of the series beginning at dtstart.
+// Wall clock time of dtstart is kept in its location for every occurrence.
+func (r *RecurrenceRule) Between(dtstart, from, to time.Time) []time.Time {
+	starts := make([]time.Time, 0)
+	emitted := 0
+
+	for period := 0; ; period++ {
+		candidates := r.periodCandidates(dtstart, period)
+		if candidates == nil {
+			return starts
+		}
+
+		for _, c := range candidates {
+			if c.Before(dtstart) {
+				continue
+			}
+
+			if !c.Before(to) || (!r.Until.IsZero() && c.After(r.Until)) {
+				return starts
+			}
+
+			emitted++
+			if !c.Before(from) {
+				starts = append(starts, c)
+			}
+
+			if r.Count > 0 && emitted >= r.Count {
+				return starts
+			}
+		}
+	}
+}
+
+// periodCandidates returns sorted occurrence candidates of the n-th period of the rule
+// or nil when the period lies beyond any representable date.
+func (r *RecurrenceRule) periodCandidates(dtstart time.Time, n int) []time.Time {
+	y, m, d := dtstart.Date()
+	hh, mm, ss := dtstart.Clock()
+	loc := dtstart.Location()
+	step := n * r.Interval
+
+	if r.periodStart(dtstart, step).Year() > 9999 {
+		return nil
+	}
+
+	switch r.Freq {
+	case FreqDaily:
+		day := time.Date(y, m, d+step, hh, mm, ss, 0, loc)
+		if len(r.ByDay) > 0 && !r.hasWeekday(day.Weekday()) {
+			return []time.Time{}
+		}
+
+		return []time.Time{day}
+	case FreqWeekly:
+		if len(r.ByDay) == 0 {
+			return []time.Time{time.Date(y, m, d+7*step, hh, mm, ss, 0, loc)}
+		}
+
+		monday := d - int(dtstart.Weekday()+6)%7 + 7*step
+		candidates := make([]time.Time, 0, len(r.ByDay))
+		for _, wd := range r.ByDay {
+			candidates = append(candidates, time.Date(y, m, monday+int(wd+6)%7, hh, mm, ss, 0, loc))
+		}
+
+		return candidates
+	case FreqMonthly:
+		first := time.Date(y, m+time.Month(step), 1, hh, mm, ss, 0, loc)
+		if len(r.ByDay) == 0 {
+			day := time.Date(first.Year(), first.Month(), d, hh, mm, ss, 0, loc)
+			if day.Month() != first.Month() {
+				// skip months without such day as RFC 5545 requires
+				return []time.Time{}
+			}
+
+			return []time.Time{day}
+		}
+
+		candidates := make([]time.Time, 0)
+		for day := first; day.Month() == first.Month(); day = day.AddDate(0, 0, 1) {
+			if r.hasWeekday(day.Weekday()) {
+				candidates = append(candidates, day)
+			}
+		}
+
+		return candidates
+	case FreqYearly:
+		day := time.Date(y+step, m, d, hh, mm, ss, 0, loc)
+		if day.Day() != d {
+			return []time.Time{}
+		}
+
+		return []time.Time{day}
+	}
+
+	return nil
+}
+
+func (r *RecurrenceRule) hasWeekday(wd time.Weekday) bool {
+	for _, v := range r.ByDay {
+		if v == wd {
+			return true
+		}
+	}
+
+	return false
+}
+
+// periodStart returns the first day of the period step units of the frequency after dtstart.
+func (r *RecurrenceRule) periodStart(dtstart time.Time, step int) time.Time {
+	y, m, d := dtstart.Date()
+
+	switch r.Freq {
+	case FreqDaily:
+		return time.Date(y, m, d+step, 0, 0, 0, 0, time.UTC)
+	case FreqWeekly:
+		return time.Date(y, m, d+7*step, 0, 0, 0, 0, time.UTC)
+	case FreqMonthly:
+		return time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(y+step, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+}
@@ -0,0 +1,136 @@
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	t.Run("test valid rules", func(t *testing.T) {
+		rule, err := storage.ParseRecurrenceRule("FREQ=WEEKLY;INTERVAL=2;BYDAY=FR,MO;COUNT=4")
+		require.NoError(t, err)
+		require.Equal(t, storage.FreqWeekly, rule.Freq)
+		require.Equal(t, 2, rule.Interval)
+		require.Equal(t, []time.Weekday{time.Monday, time.Friday}, rule.ByDay)
+		require.Equal(t, 4, rule.Count)
+		require.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;COUNT=4", rule.String())
+
+		rule, err = storage.ParseRecurrenceRule("RRULE:FREQ=DAILY;UNTIL=20201015")
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2020, 10, 15, 23, 59, 59, 0, time.UTC), rule.Until)
+	})
+
+	t.Run("test invalid rules", func(t *testing.T) {
+		for _, rule := range []string{
+			"",
+			"FREQ=HOURLY",
+			"FREQ=DAILY;INTERVAL=0",
+			"FREQ=DAILY;COUNT=2;UNTIL=20201015",
+			"FREQ=WEEKLY;BYDAY=1MO",
+			"FREQ=YEARLY;BYDAY=MO",
+			"FREQ=DAILY;BYSETPOS=1",
+		} {
+			_, err := storage.ParseRecurrenceRule(rule)
+			require.ErrorIs(t, err, storage.ErrInvalidRecurrenceRule, rule)
+		}
+	})
+}
+
+func TestRecurrenceRuleBetween(t *testing.T) {
+	// thursday
+	dtstart := time.Date(2020, 10, 1, 10, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		rule     string
+		dtstart  time.Time
+		from, to time.Time
+		exp      []time.Time
+	}{
+		"daily with count": {
+			rule: "FREQ=DAILY;COUNT=3",
+			from: dtstart,
+			to:   dtstart.AddDate(0, 1, 0),
+			exp: []time.Time{
+				dtstart,
+				dtstart.AddDate(0, 0, 1),
+				dtstart.AddDate(0, 0, 2),
+			},
+		},
+		"daily with count before interval": {
+			rule: "FREQ=DAILY;COUNT=3",
+			from: dtstart.AddDate(0, 0, 3),
+			to:   dtstart.AddDate(0, 1, 0),
+			exp:  []time.Time{},
+		},
+		"weekly by days with interval": {
+			rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+			from: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2020, 10, 20, 0, 0, 0, 0, time.UTC),
+			exp: []time.Time{
+				time.Date(2020, 10, 1, 10, 30, 0, 0, time.UTC),
+				time.Date(2020, 10, 12, 10, 30, 0, 0, time.UTC),
+				time.Date(2020, 10, 15, 10, 30, 0, 0, time.UTC),
+			},
+		},
+		"weekly until": {
+			rule: "FREQ=WEEKLY;UNTIL=20201015T103000Z",
+			from: dtstart,
+			to:   dtstart.AddDate(1, 0, 0),
+			exp: []time.Time{
+				dtstart,
+				dtstart.AddDate(0, 0, 7),
+				dtstart.AddDate(0, 0, 14),
+			},
+		},
+		"monthly skips short months": {
+			rule:    "FREQ=MONTHLY;COUNT=3",
+			dtstart: time.Date(2020, 12, 31, 10, 30, 0, 0, time.UTC),
+			from:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:      time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+			exp: []time.Time{
+				time.Date(2021, 1, 31, 10, 30, 0, 0, time.UTC),
+				time.Date(2021, 3, 31, 10, 30, 0, 0, time.UTC),
+			},
+		},
+		"single day of daily series": {
+			rule: "FREQ=DAILY",
+			from: time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2021, 5, 4, 0, 0, 0, 0, time.UTC),
+			exp:  []time.Time{time.Date(2021, 5, 3, 10, 30, 0, 0, time.UTC)},
+		},
+		"long-running daily series": {
+			rule: "FREQ=DAILY",
+			from: time.Date(2045, 5, 3, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2045, 5, 5, 0, 0, 0, 0, time.UTC),
+			exp: []time.Time{
+				time.Date(2045, 5, 3, 10, 30, 0, 0, time.UTC),
+				time.Date(2045, 5, 4, 10, 30, 0, 0, time.UTC),
+			},
+		},
+		"long-running weekly series": {
+			rule: "FREQ=WEEKLY",
+			from: time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2200, 1, 8, 0, 0, 0, 0, time.UTC),
+			exp:  []time.Time{time.Date(2200, 1, 2, 10, 30, 0, 0, time.UTC)},
+		},
+	}
+
+	for testName, data := range tests {
+		data := data
+
+		t.Run(testName, func(t *testing.T) {
+			rule, err := storage.ParseRecurrenceRule(data.rule)
+			require.NoError(t, err)
+
+			start := dtstart
+			if !data.dtstart.IsZero() {
+				start = data.dtstart
+			}
+
+			require.Equal(t, data.exp, rule.Between(start, data.from, data.to))
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// limits of reminders.
+const (
+	MaxReminderOffset = 28 * 24 * time.Hour
+	// DefaultReminderDelay is how late a reminder may be sent unless configured, older reminders are skipped.
+	DefaultReminderDelay = time.Hour
+)
+
+// maxRemindWindow bounds windows the next occurrence of endless series is looked for in.
+const maxRemindWindow = 8 * 366 * 24 * time.Hour
+
+// DefaultReminders notify at the start of the event.
+var DefaultReminders = []time.Duration{0}
+
+var ErrInvalidReminder = errors.New("invalid reminder offset")
+
+// Reminder notifies about every occurrence of the event Offset before its start.
+type Reminder struct {
+	EventID string
+	Offset  time.Duration
+	// LastOccurrence is start of the latest occurrence the reminder was sent for.
+	LastOccurrence time.Time
+	// RemindAt is time the reminder is checked at, no later than its first occurrence not reminded about.
+	RemindAt time.Time
+}
+
+// ReminderCheck is state of the reminder at some time.
+type ReminderCheck struct {
+	// Due is the occurrence to remind about, nil when there is none
+	Due *DueReminder
+	// Skipped is set when the first occurrence not reminded about is late more than the allowed delay
+	Skipped bool
+	// RemindAt is time to check the reminder at next, zero when no occurrences are left
+	RemindAt time.Time
+}
+
+// DueReminder is a reminder about the occurrence which time has come.
+type DueReminder struct {
+	Reminder
+	Occurrence *Event
+}
+
+// RemindAt returns time the reminder should be sent at.
+func (r *DueReminder) RemindAt() time.Time {
+	return r.Occurrence.Start.Add(-r.Offset)
+}
+
+// SetReminders validates offsets of reminders before the start, keeps them sorted and unique in minutes.
+func (e *Event) SetReminders(offsets []time.Duration) error {
+	seen := make(map[time.Duration]bool, len(offsets))
+	reminders := make([]time.Duration, 0, len(offsets))
+
+	for _, offset := range offsets {
+		if offset < 0 || offset > MaxReminderOffset {
+			return fmt.Errorf("offset %v: %w", offset, ErrInvalidReminder)
+		}
+
+		offset = offset.Truncate(time.Minute)
+		if !seen[offset] {
+			seen[offset] = true
+			reminders = append(reminders, offset)
+		}
+	}
+
+	sort.Slice(reminders, func(i, j int) bool {
+		return reminders[i] < reminders[j]
+	})
+
+	e.Reminders = reminders
+
+	return nil
+}
+
+// DueReminder returns the latest occurrence of the event the reminder is due for at now and was not sent for,
+// or nil if there is no such occurrence, occurrences late more than maxDelay are skipped.
+func (e *Event) DueReminder(r Reminder, now time.Time, maxDelay time.Duration) (*DueReminder, error) {
+	from := now.Add(-maxDelay).Add(r.Offset)
+	to := now.Add(r.Offset).Add(time.Nanosecond)
+
+	occurrences, err := e.Occurrences(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var due *DueReminder
+
+	for _, o := range occurrences {
+		if !o.Start.After(from) || !o.Start.Before(to) || !o.Start.After(r.LastOccurrence) {
+			continue
+		}
+
+		if due == nil || o.Start.After(due.Occurrence.Start) {
+			due = &DueReminder{Reminder: r, Occurrence: o}
+		}
+	}
+
+	return due, nil
+}
+
+// CheckReminder returns the due occurrence of the reminder at now, whether the first occurrence not reminded about
+// is skipped being late more than maxDelay and time to check the reminder at next. The occurrence is skipped once
+// when the reminder is checked at RemindAt, later checks start from the returned time.
+func (e *Event) CheckReminder(r Reminder, now time.Time, maxDelay time.Duration) (*ReminderCheck, error) {
+	due, err := e.DueReminder(r, now, maxDelay)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := e.NextRemindAt(r, r.LastOccurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	late := now.Add(-maxDelay)
+	check := &ReminderCheck{
+		Due:     due,
+		Skipped: !r.RemindAt.After(late) && !next.IsZero() && !next.After(late),
+	}
+
+	if due != nil {
+		check.RemindAt = due.RemindAt()
+
+		return check, nil
+	}
+
+	after := now.Add(r.Offset)
+	if r.LastOccurrence.After(after) {
+		after = r.LastOccurrence
+	}
+
+	check.RemindAt, err = e.NextRemindAt(r, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return check, nil
+}
+
+// NextRemindAt returns time to send the reminder at about the first occurrence starting after the time,
+// or zero time when there is no such occurrence.
+func (e *Event) NextRemindAt(r Reminder, after time.Time) (time.Time, error) {
+	if !e.IsRecurring() {
+		if e.Start.After(after) {
+			return e.Start.Add(-r.Offset), nil
+		}
+
+		return time.Time{}, nil
+	}
+
+	rule, err := ParseRecurrenceRule(e.RRule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cant parse rrule of event %s: %w", e.ID, err)
+	}
+
+	// finite series are expanded at once, endless ones in growing windows till the first occurrence
+	from, to := after, after.Add(24*time.Hour)
+	if rule.Count > 0 || !rule.Until.IsZero() {
+		to = endOfTime
+	}
+
+	for from.Before(endOfTime) {
+		occurrences, err := e.Occurrences(from, to)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		for _, o := range occurrences {
+			if o.Start.After(after) {
+				return o.Start.Add(-r.Offset), nil
+			}
+		}
+
+		window := to.Sub(from)
+		if window < maxRemindWindow {
+			window *= 2
+		}
+
+		from, to = to, to.Add(window)
+	}
+
+	return time.Time{}, nil
+}
+
+// SortDueReminders sorts reminders by time to send them and returns at most limit of the earliest.
+func SortDueReminders(reminders []*DueReminder, limit int64) []*DueReminder {
+	sort.Slice(reminders, func(i, j int) bool {
+		if !reminders[i].RemindAt().Equal(reminders[j].RemindAt()) {
+			return reminders[i].RemindAt().Before(reminders[j].RemindAt())
+		}
+
+		return reminders[i].EventID < reminders[j].EventID
+	})
+
+	if int64(len(reminders)) > limit {
+		reminders = reminders[:limit]
+	}
+
+	return reminders
+}
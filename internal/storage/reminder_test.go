@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetReminders(t *testing.T) {
+	e := &Event{}
+
+	require.NoError(t, e.SetReminders([]time.Duration{24 * time.Hour, 15*time.Minute + 30*time.Second, 15 * time.Minute}))
+	require.Equal(t, []time.Duration{15 * time.Minute, 24 * time.Hour}, e.Reminders)
+
+	for _, offsets := range [][]time.Duration{{-time.Minute}, {MaxReminderOffset + time.Minute}} {
+		require.ErrorIs(t, e.SetReminders(offsets), ErrInvalidReminder)
+	}
+}
+
+func TestDueReminder(t *testing.T) {
+	now := time.Date(2021, 5, 3, 9, 45, 0, 0, time.UTC)
+	start := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	delay := DefaultReminderDelay
+
+	t.Run("single event", func(t *testing.T) {
+		e := &Event{ID: "event1", Start: start, Finish: start.Add(time.Hour)}
+
+		due, err := e.DueReminder(Reminder{EventID: e.ID, Offset: 15 * time.Minute}, now, delay)
+		require.NoError(t, err)
+		require.NotNil(t, due)
+		require.Equal(t, start, due.Occurrence.Start)
+		require.Equal(t, now, due.RemindAt())
+
+		due, err = e.DueReminder(Reminder{EventID: e.ID, Offset: 10 * time.Minute}, now, delay)
+		require.NoError(t, err)
+		require.Nil(t, due)
+
+		due, err = e.DueReminder(Reminder{EventID: e.ID, Offset: 15 * time.Minute, LastOccurrence: start}, now, delay)
+		require.NoError(t, err)
+		require.Nil(t, due)
+
+		due, err = e.DueReminder(Reminder{EventID: e.ID, Offset: 15 * time.Minute}, now.Add(delay), delay)
+		require.NoError(t, err)
+		require.Nil(t, due, "too late reminder is skipped")
+	})
+
+	t.Run("recurring event", func(t *testing.T) {
+		e := &Event{ID: "event1", Start: start.AddDate(0, 0, -7), Finish: start.AddDate(0, 0, -7).Add(time.Hour)}
+		require.NoError(t, e.SetRecurrence("FREQ=DAILY"))
+
+		due, err := e.DueReminder(Reminder{EventID: e.ID, Offset: 15 * time.Minute, LastOccurrence: start.AddDate(0, 0, -1)},
+			now, delay)
+		require.NoError(t, err)
+		require.NotNil(t, due)
+		require.Equal(t, start, due.Occurrence.Start)
+		require.Equal(t, e.ID, due.Occurrence.RecurringEventID)
+
+		due, err = e.DueReminder(Reminder{EventID: e.ID, Offset: 15 * time.Minute, LastOccurrence: start}, now, delay)
+		require.NoError(t, err)
+		require.Nil(t, due)
+	})
+}
+
+func TestCheckReminder(t *testing.T) {
+	now := time.Date(2021, 5, 3, 9, 45, 0, 0, time.UTC)
+	start := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	r := Reminder{EventID: "event1", Offset: 15 * time.Minute}
+
+	t.Run("single event", func(t *testing.T) {
+		e := &Event{ID: "event1", Start: start, Finish: start.Add(time.Hour)}
+
+		check, err := e.CheckReminder(r, now.Add(-time.Minute), time.Hour)
+		require.NoError(t, err)
+		require.Nil(t, check.Due)
+		require.False(t, check.Skipped)
+		require.Equal(t, now, check.RemindAt)
+
+		check, err = e.CheckReminder(r, now, time.Hour)
+		require.NoError(t, err)
+		require.NotNil(t, check.Due)
+		require.Equal(t, now, check.RemindAt)
+
+		check, err = e.CheckReminder(r, now.Add(2*time.Hour), time.Hour)
+		require.NoError(t, err)
+		require.Nil(t, check.Due)
+		require.True(t, check.Skipped, "too late reminder is skipped")
+		require.True(t, check.RemindAt.IsZero())
+
+		check, err = e.CheckReminder(r, now.Add(2*time.Hour), 3*time.Hour)
+		require.NoError(t, err)
+		require.NotNil(t, check.Due, "the delay is configurable")
+		require.False(t, check.Skipped)
+	})
+
+	t.Run("recurring event", func(t *testing.T) {
+		e := &Event{ID: "event1", Start: start.AddDate(0, 0, -7), Finish: start.AddDate(0, 0, -7).Add(time.Hour)}
+		require.NoError(t, e.SetRecurrence("FREQ=DAILY"))
+
+		check, err := e.CheckReminder(Reminder{EventID: e.ID, Offset: r.Offset, LastOccurrence: start.AddDate(0, 0, -3)},
+			now, time.Hour)
+		require.NoError(t, err)
+		require.NotNil(t, check.Due)
+		require.Equal(t, start, check.Due.Occurrence.Start)
+		require.True(t, check.Skipped, "occurrences of two days before are skipped")
+
+		check, err = e.CheckReminder(Reminder{EventID: e.ID, Offset: r.Offset, LastOccurrence: start.AddDate(0, 0, -3)},
+			now.Add(2*time.Hour), time.Hour)
+		require.NoError(t, err)
+		require.Nil(t, check.Due)
+		require.True(t, check.Skipped)
+		require.Equal(t, now.AddDate(0, 0, 1), check.RemindAt, "the reminder is checked at the next occurrence")
+	})
+}
+
+func TestNextRemindAt(t *testing.T) {
+	start := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	r := Reminder{EventID: "event1", Offset: time.Hour}
+
+	for _, tc := range []struct {
+		name     string
+		rrule    string
+		after    time.Time
+		expected time.Time
+	}{
+		{"single event", "", start.Add(-time.Minute), start.Add(-time.Hour)},
+		{"started single event", "", start, time.Time{}},
+		{"first occurrence", "FREQ=DAILY", time.Time{}, start.Add(-time.Hour)},
+		{"next occurrence", "FREQ=DAILY", start.AddDate(0, 0, 2), start.AddDate(0, 0, 3).Add(-time.Hour)},
+		{"rare occurrence", "FREQ=YEARLY", start.AddDate(10, 0, 0), start.AddDate(11, 0, 0).Add(-time.Hour)},
+		{"ended series", "FREQ=DAILY;COUNT=3", start.AddDate(0, 0, 2), time.Time{}},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			e := &Event{ID: "event1", Start: start, Finish: start.Add(time.Hour)}
+			if tc.rrule != "" {
+				require.NoError(t, e.SetRecurrence(tc.rrule))
+			}
+
+			remindAt, err := e.NextRemindAt(r, tc.after)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, remindAt)
+		})
+	}
+}
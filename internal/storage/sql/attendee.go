@@ -0,0 +1,32 @@
+package sqlstorage
+
+import (
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+type Attendee struct {
+	EventID    string     `db:"event_id"`
+	UserID     string     `db:"user_id"`
+	Email      string     `db:"email"`
+	Role       string     `db:"role"`
+	Status     string     `db:"status"`
+	DateUpdate *time.Time `db:"date_update"`
+}
+
+func (a *Attendee) ToApp() storage.Attendee {
+	attendee := storage.Attendee{
+		EventID: a.EventID,
+		UserID:  a.UserID,
+		Email:   a.Email,
+		Role:    storage.AttendeeRole(a.Role),
+		Status:  storage.RSVPStatus(a.Status),
+	}
+
+	if a.DateUpdate != nil {
+		attendee.UpdatedAt = *a.DateUpdate
+	}
+
+	return attendee
+}
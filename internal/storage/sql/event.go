@@ -7,22 +7,60 @@ import (
 )
 
 type Event struct {
-	ID             string    `db:"id"`
-	Title          string    `db:"title"`
-	Description    string    `db:"description"`
-	DatetimeStart  time.Time `db:"datetime_start"`
-	DatetimeFinish time.Time `db:"datetime_finish"`
-	Processed      bool      `db:"processed"`
-	DateAdd        time.Time `db:"date_add"`
+	ID               string      `db:"id"`
+	UserID           string      `db:"user_id"`
+	Title            string      `db:"title"`
+	Description      string      `db:"description"`
+	DatetimeStart    time.Time   `db:"datetime_start"`
+	DatetimeFinish   time.Time   `db:"datetime_finish"`
+	AllDay           bool        `db:"all_day"`
+	TimeZone         string      `db:"time_zone"`
+	RRule            string      `db:"rrule"`
+	ExDates          []time.Time `db:"exdates"`
+	RecurringEventID *string     `db:"recurring_event_id"`
+	RecurrenceID     *time.Time  `db:"recurrence_id"`
+	Processed        bool        `db:"processed"`
+	DateAdd          time.Time   `db:"date_add"`
+	DateUpdate       *time.Time  `db:"date_update"`
 }
 
+// ToApp converts the row to app event with times in time zone of the event,
+// dates of all-day events are kept in UTC.
 func (e *Event) ToApp() storage.Event {
+	loc, err := storage.LoadLocation(e.TimeZone)
+	if err != nil || e.AllDay {
+		loc = time.UTC
+	}
+
 	event := storage.Event{}
 	event.ID = e.ID
+	event.UserID = e.UserID
 	event.Title = e.Title
 	event.Description = e.Description
-	event.Start = e.DatetimeStart
-	event.Finish = e.DatetimeFinish
+	event.Start = e.DatetimeStart.In(loc)
+	event.Finish = e.DatetimeFinish.In(loc)
+	event.AllDay = e.AllDay
+	event.TimeZone = e.TimeZone
+	event.RRule = e.RRule
+
+	for _, d := range e.ExDates {
+		event.ExDates = append(event.ExDates, d.In(loc))
+	}
+
+	if e.RecurringEventID != nil {
+		event.RecurringEventID = *e.RecurringEventID
+	}
+
+	if e.RecurrenceID != nil {
+		event.RecurrenceID = e.RecurrenceID.In(loc)
+	}
+
+	event.CreatedAt = e.DateAdd
+	if e.DateUpdate != nil {
+		event.UpdatedAt = *e.DateUpdate
+	}
+
+	event.Processed = e.Processed
 
 	return event
 }
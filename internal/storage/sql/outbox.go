@@ -0,0 +1,31 @@
+package sqlstorage
+
+import (
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+type OutboxMessage struct {
+	ID          int64      `db:"id"`
+	DedupKey    string     `db:"dedup_key"`
+	Payload     []byte     `db:"payload"`
+	DateAdd     time.Time  `db:"date_add"`
+	LockedUntil *time.Time `db:"locked_until"`
+	DateSent    *time.Time `db:"date_sent"`
+}
+
+func (m *OutboxMessage) ToApp() storage.OutboxMessage {
+	message := storage.OutboxMessage{
+		ID:        m.ID,
+		Key:       m.DedupKey,
+		Payload:   m.Payload,
+		CreatedAt: m.DateAdd,
+	}
+
+	if m.DateSent != nil {
+		message.SentAt = *m.DateSent
+	}
+
+	return message
+}
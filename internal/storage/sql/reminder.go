@@ -0,0 +1,49 @@
+package sqlstorage
+
+import (
+	"time"
+
+	"github.com/seregproj/calendar/internal/storage"
+)
+
+type Reminder struct {
+	EventID        string     `db:"event_id"`
+	OffsetMinutes  int64      `db:"offset_minutes"`
+	LastOccurrence *time.Time `db:"last_occurrence"`
+	LockedUntil    *time.Time `db:"locked_until"`
+	// RemindAt is time to check the reminder at next, NULL when no occurrences are left
+	RemindAt *time.Time `db:"remind_at"`
+}
+
+func (r *Reminder) ToApp() storage.Reminder {
+	reminder := storage.Reminder{
+		EventID: r.EventID,
+		Offset:  time.Duration(r.OffsetMinutes) * time.Minute,
+	}
+
+	if r.LastOccurrence != nil {
+		reminder.LastOccurrence = *r.LastOccurrence
+	}
+
+	if r.RemindAt != nil {
+		reminder.RemindAt = *r.RemindAt
+	}
+
+	return reminder
+}
+
+// EventReminder is a row of the event joined with its reminder.
+type EventReminder struct {
+	Event
+	OffsetMinutes  int64      `db:"offset_minutes"`
+	LastOccurrence *time.Time `db:"last_occurrence"`
+	RemindAt       *time.Time `db:"remind_at"`
+}
+
+func (r *EventReminder) ToApp() (storage.Event, storage.Reminder) {
+	reminder := Reminder{
+		EventID: r.ID, OffsetMinutes: r.OffsetMinutes, LastOccurrence: r.LastOccurrence, RemindAt: r.RemindAt,
+	}
+
+	return r.Event.ToApp(), reminder.ToApp()
+}
@@ -3,10 +3,13 @@ package sqlstorage
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/seregproj/calendar/internal/app/calendar"
 	"github.com/seregproj/calendar/internal/storage"
 )
 
@@ -33,18 +36,146 @@ func (s *Storage) Close(ctx context.Context) {
 	s.pool.Close()
 }
 
-func (s *Storage) ExistsEventByID(ctx context.Context, uuid string) (bool, error) {
-	ct, err := s.pool.Exec(ctx, "SELECT 1 FROM events where id = $1", uuid)
+func (s *Storage) GetEventByID(ctx context.Context, uuid string) (*storage.Event, error) {
+	var eventDB Event
+	if err := pgxscan.Get(ctx, s.pool, &eventDB, "SELECT * FROM events WHERE id = $1", uuid); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, calendar.ErrEventNotFound
+		}
+
+		return nil, fmt.Errorf("cant do select: %w", err)
+	}
+
+	event := eventDB.ToApp()
+
+	var remindersDB []Reminder
+	if err := pgxscan.Select(ctx, s.pool, &remindersDB,
+		"SELECT * FROM event_reminders WHERE event_id = $1 ORDER BY offset_minutes", uuid); err != nil {
+		return nil, fmt.Errorf("cant do select: %w", err)
+	}
+
+	for _, item := range remindersDB {
+		event.Reminders = append(event.Reminders, item.ToApp().Offset)
+	}
+
+	return &event, nil
+}
+
+// PurgeEvents deletes at most limit of events finished before the time, archiving them if asked.
+// Single events and overridden occurrences go first, series are purged after all their overrides.
+func (s *Storage) PurgeEvents(ctx context.Context, before time.Time, archive bool, limit int64) (int64, error) {
+	var purged int64
+
+	err := s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var ids []string
+		if err := pgxscan.Select(ctx, tx, &ids,
+			"SELECT e.id FROM events e WHERE e.rrule = '' AND e.datetime_finish < $1 "+
+				"ORDER BY e.datetime_finish LIMIT $2 FOR UPDATE SKIP LOCKED", before, limit); err != nil {
+			return fmt.Errorf("cant do select: %w", err)
+		}
+
+		if int64(len(ids)) < limit {
+			// end of the series is known by expanding its rule only
+			var seriesDB []Event
+			if err := pgxscan.Select(ctx, tx, &seriesDB,
+				"SELECT e.* FROM events e WHERE e.rrule <> '' AND e.datetime_start < $1 "+
+					"AND (e.rrule LIKE '%COUNT=%' OR e.rrule LIKE '%UNTIL=%') "+
+					"AND NOT EXISTS (SELECT 1 FROM events o WHERE o.recurring_event_id = e.id) "+
+					"ORDER BY e.datetime_start FOR UPDATE OF e SKIP LOCKED", before); err != nil {
+				return fmt.Errorf("cant do select: %w", err)
+			}
+
+			for _, item := range seriesDB {
+				if int64(len(ids)) >= limit {
+					break
+				}
+
+				event := item.ToApp()
+
+				ended, err := event.EndsBefore(before)
+				if err != nil {
+					return fmt.Errorf("cant check event end: %w", err)
+				}
+
+				if ended {
+					ids = append(ids, event.ID)
+				}
+			}
+		}
+
+		if archive {
+			if err := archiveEvents(ctx, tx, ids); err != nil {
+				return err
+			}
+		}
+
+		res, err := tx.Exec(ctx, "DELETE FROM events WHERE id = ANY($1)", ids)
+		if err != nil {
+			return fmt.Errorf("exec error: %w", err)
+		}
+
+		purged = res.RowsAffected()
+
+		return nil
+	})
 	if err != nil {
-		return false, fmt.Errorf("cant exec: %w", err)
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// archiveEvents copies the events along with their attendees and reminders to the archive.
+func archiveEvents(ctx context.Context, tx pgx.Tx, ids []string) error {
+	for _, query := range []string{
+		"INSERT INTO events_archive(" + archivedEventColumns + ", date_archive) " +
+			"SELECT " + archivedEventColumns + ", CURRENT_TIMESTAMP FROM events WHERE id = ANY($1)",
+		"INSERT INTO event_attendees_archive(" + archivedAttendeeColumns + ") " +
+			"SELECT " + archivedAttendeeColumns + " FROM event_attendees WHERE event_id = ANY($1)",
+		"INSERT INTO event_reminders_archive(" + archivedReminderColumns + ") " +
+			"SELECT " + archivedReminderColumns + " FROM event_reminders WHERE event_id = ANY($1)",
+	} {
+		if _, err := tx.Exec(ctx, query, ids); err != nil {
+			return fmt.Errorf("exec error: %w", err)
+		}
 	}
 
-	return ct.RowsAffected() > 0, nil
+	return nil
+}
+
+func (s *Storage) GetEventOverrides(ctx context.Context, uuid string) ([]*storage.Event, error) {
+	var eventsDB []Event
+	if err := pgxscan.Select(ctx, s.pool, &eventsDB,
+		"SELECT * FROM events WHERE recurring_event_id = $1 ORDER BY recurrence_id", uuid); err != nil {
+		return nil, fmt.Errorf("cant do select: %w", err)
+	}
+
+	events := make([]*storage.Event, 0, len(eventsDB))
+	for _, item := range eventsDB {
+		event := item.ToApp()
+		events = append(events, &event)
+	}
+
+	return events, nil
 }
 
 func (s *Storage) CreateEvent(ctx context.Context, event *storage.Event) error {
-	_, err := s.pool.Exec(ctx, "INSERT INTO events(id, title, description, datetime_start, datetime_finish) "+
-		"VALUES ($1, $2, $3, $4, $5)", event.ID, event.Title, event.Description, event.Start, event.Finish)
+	return s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := createEvent(ctx, tx, event); err != nil {
+			return err
+		}
+
+		return saveReminders(ctx, tx, event.ID, event.Reminders)
+	})
+}
+
+func createEvent(ctx context.Context, tx pgx.Tx, event *storage.Event) error {
+	_, err := tx.Exec(ctx, "INSERT INTO events(id, user_id, title, description, datetime_start, datetime_finish, "+
+		"all_day, time_zone, rrule, exdates, recurring_event_id, recurrence_id, date_add) "+
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, COALESCE($13, CURRENT_TIMESTAMP))",
+		event.ID, event.UserID, event.Title, event.Description, event.Start, event.Finish, event.AllDay, timeZone(event),
+		event.RRule, exDates(event), nullString(event.RecurringEventID), nullTime(event.RecurrenceID),
+		nullTime(event.CreatedAt))
 	if err != nil {
 		return fmt.Errorf("exec error: %w", err)
 	}
@@ -53,67 +184,471 @@ func (s *Storage) CreateEvent(ctx context.Context, event *storage.Event) error {
 }
 
 func (s *Storage) UpdateEvent(ctx context.Context, uuid string, event *storage.Event) error {
-	_, err := s.pool.Exec(ctx, "UPDATE events SET title=$1, description=$2, datetime_start=$3, datetime_finish=$4"+
-		"  WHERE id=$5", event.Title, event.Description, event.Start, event.Finish, uuid)
+	return s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := updateEvent(ctx, tx, uuid, event); err != nil {
+			return err
+		}
+
+		return saveReminders(ctx, tx, uuid, event.Reminders)
+	})
+}
+
+func updateEvent(ctx context.Context, tx pgx.Tx, uuid string, event *storage.Event) error {
+	ct, err := tx.Exec(ctx, "UPDATE events SET title=$1, description=$2, datetime_start=$3, datetime_finish=$4, "+
+		"all_day=$5, time_zone=$6, rrule=$7, exdates=$8, recurring_event_id=$9, recurrence_id=$10, "+
+		"date_update=COALESCE($11, CURRENT_TIMESTAMP) WHERE id=$12 AND user_id=$13",
+		event.Title, event.Description, event.Start, event.Finish, event.AllDay, timeZone(event), event.RRule,
+		exDates(event), nullString(event.RecurringEventID), nullTime(event.RecurrenceID), nullTime(event.UpdatedAt),
+		uuid, event.UserID)
 	if err != nil {
 		return fmt.Errorf("exec error: %w", err)
 	}
 
+	if ct.RowsAffected() == 0 {
+		return calendar.ErrEventNotFound
+	}
+
 	return nil
 }
 
-func (s *Storage) DeleteEvent(ctx context.Context, uuid string) error {
-	_, err := s.pool.Exec(ctx, "DELETE FROM events WHERE id=$1", uuid)
+// SplitSeries stores the truncated series, deletes its overridden occurrences starting from recurrenceID and
+// creates the rest of the series with attendees of the series in one transaction, the rest is skipped when nil.
+func (s *Storage) SplitSeries(ctx context.Context, series *storage.Event, recurrenceID time.Time,
+	rest *storage.Event) error {
+	return s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "DELETE FROM events WHERE recurring_event_id = $1 AND recurrence_id >= $2",
+			series.ID, recurrenceID); err != nil {
+			return fmt.Errorf("exec error: %w", err)
+		}
+
+		if err := updateEvent(ctx, tx, series.ID, series); err != nil {
+			return err
+		}
+
+		if err := saveReminders(ctx, tx, series.ID, series.Reminders); err != nil {
+			return err
+		}
+
+		if rest == nil {
+			return nil
+		}
+
+		if err := createEvent(ctx, tx, rest); err != nil {
+			return err
+		}
+
+		if err := saveReminders(ctx, tx, rest.ID, rest.Reminders); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, "INSERT INTO event_attendees(event_id, user_id, email, role, status, date_update) "+
+			"SELECT $1, user_id, email, role, status, date_update FROM event_attendees WHERE event_id = $2",
+			rest.ID, series.ID); err != nil {
+			return fmt.Errorf("exec error: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (s *Storage) DeleteEvent(ctx context.Context, userID, uuid string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM events WHERE id=$1 AND user_id=$2", uuid, userID)
 	if err != nil {
 		return fmt.Errorf("exec error: %w", err)
 	}
 
+	if res.RowsAffected() == 0 {
+		return calendar.ErrEventNotFound
+	}
+
 	return nil
 }
 
-func (s *Storage) GetEventsByDaySorted(ctx context.Context, date time.Time, limit int64, offset int64) (
+// overlapCondition selects events overlapping [$2, $3) and all-day events overlapping dates [$4, $5),
+// recurring series started before the end of the interval are selected to be expanded.
+const overlapCondition = "((rrule = '' AND NOT all_day AND datetime_start < $3 AND " +
+	"(datetime_finish > $2 OR datetime_start >= $2)) " +
+	"OR (rrule = '' AND all_day AND datetime_start < $5 AND datetime_finish > $4) " +
+	"OR (rrule <> '' AND datetime_start < CASE WHEN all_day THEN $5 ELSE $3 END))"
+
+func (s *Storage) GetEventsByDaySorted(ctx context.Context, userID string, date time.Time, limit int64,
+	offset int64) (
 	[]*storage.Event,
 	error) {
 	dateTo := date.AddDate(0, 0, 1)
+	// all-day events are stored as UTC midnights and compared with the dates of the day
+	floatingFrom, floatingTo := storage.FloatingWindow(date, dateTo)
 
+	// recurring series are expanded here, so all of them started before the end of the day are needed
 	var eventsDB []Event
 	if err := pgxscan.Select(ctx, s.pool, &eventsDB,
-		"SELECT * FROM events where datetime_start >= $1 AND datetime_start < $2 LIMIT $3 OFFSET $4",
-		date, dateTo, limit, offset); err != nil {
+		"SELECT * FROM events WHERE user_id = $1 AND "+overlapCondition+" ORDER BY all_day DESC, datetime_start",
+		userID, date, dateTo, floatingFrom, floatingTo); err != nil {
 		return nil, fmt.Errorf("cant do select: %w", err)
 	}
 
-	events := make([]*storage.Event, 0, len(eventsDB))
+	candidates := make([]*storage.Event, 0, len(eventsDB))
 	for _, item := range eventsDB {
 		event := item.ToApp()
-		events = append(events, &event)
+		candidates = append(candidates, &event)
 	}
 
-	return events, nil
+	events, err := storage.ExpandEvents(candidates, date, dateTo)
+	if err != nil {
+		return nil, fmt.Errorf("cant expand events: %w", err)
+	}
+
+	return storage.PageEvents(events, limit, offset), nil
 }
 
-func (s *Storage) GetUnprocessedActualEvents(ctx context.Context, limit int64) ([]*storage.Event, error) {
+func (s *Storage) GetEventsByRangeSorted(ctx context.Context, userID string, from, to time.Time,
+	after *storage.Cursor, limit int64) ([]*storage.Event, error) {
+	floatingFrom, floatingTo := storage.FloatingWindow(from, to)
+
+	if after == nil {
+		after = &storage.Cursor{}
+	}
+
+	// single events are paged by the keyset here, recurring series are expanded and paged in Go
 	var eventsDB []Event
 	if err := pgxscan.Select(ctx, s.pool, &eventsDB,
-		"SELECT * FROM events where datetime_start < $1 AND NOT processed LIMIT $2", time.Now(), limit); err != nil {
+		"(SELECT * FROM events WHERE user_id = $1 AND rrule = '' AND "+overlapCondition+
+			" AND (datetime_start, id::text COLLATE \"C\") > ($6, $7) ORDER BY datetime_start, id LIMIT $8) "+
+			"UNION ALL (SELECT * FROM events WHERE user_id = $1 AND rrule <> '' AND "+overlapCondition+")",
+		userID, from, to, floatingFrom, floatingTo, after.Start, after.ID, limit); err != nil {
 		return nil, fmt.Errorf("cant do select: %w", err)
 	}
 
-	events := make([]*storage.Event, 0, len(eventsDB))
+	candidates := make([]*storage.Event, 0, len(eventsDB))
+	for _, item := range eventsDB {
+		event := item.ToApp()
+		candidates = append(candidates, &event)
+	}
+
+	events, err := storage.ExpandEvents(candidates, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("cant expand events: %w", err)
+	}
 
+	storage.SortByStart(events)
+
+	return storage.PageEventsAfter(events, after, limit), nil
+}
+
+func (s *Storage) GetBusyEventsOfUsers(ctx context.Context, userIDs []string, from, to time.Time) (
+	[]*storage.Event,
+	error) {
+	// single events are found by GiST index on their ranges, recurring series are expanded in Go,
+	// dates of all-day events are taken a day around the interval as they are busy in their time zones
+	var eventsDB []Event
+	if err := pgxscan.Select(ctx, s.pool, &eventsDB,
+		"SELECT * FROM events WHERE user_id = ANY($1) AND ("+
+			"(rrule = '' AND NOT all_day AND tstzrange(datetime_start, datetime_finish) && tstzrange($2, $3)) "+
+			"OR (rrule = '' AND all_day AND tstzrange(datetime_start, datetime_finish) && tstzrange($4, $5)) "+
+			"OR (rrule <> '' AND datetime_start < $5))",
+		userIDs, from, to, from.AddDate(0, 0, -1), to.AddDate(0, 0, 1)); err != nil {
+		return nil, fmt.Errorf("cant do select: %w", err)
+	}
+
+	candidates := make([]*storage.Event, 0, len(eventsDB))
 	for _, item := range eventsDB {
 		event := item.ToApp()
-		events = append(events, &event)
+		candidates = append(candidates, &event)
+	}
+
+	events, err := storage.BusyEvents(candidates, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("cant expand events: %w", err)
 	}
 
 	return events, nil
 }
 
-func (s *Storage) UpdateEventAsProcessed(ctx context.Context, event *storage.Event) error {
-	_, err := s.pool.Exec(ctx, "UPDATE events SET processed=true WHERE id=$1", event.ID)
+// ClaimDueReminders returns due reminders not claimed by others and leases them till now + lease,
+// rows being claimed by concurrent schedulers are skipped. It also returns number of reminders skipped
+// being late more than maxDelay.
+func (s *Storage) ClaimDueReminders(ctx context.Context, now time.Time, lease, maxDelay time.Duration, limit int64) (
+	[]*storage.DueReminder,
+	int64,
+	error) {
+	var (
+		reminders []*storage.DueReminder
+		skipped   int64
+	)
+
+	err := s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		// reminders of single events and recurring series are claimed in one batch of the earliest ones
+		var remindersDB []EventReminder
+		if err := pgxscan.Select(ctx, tx, &remindersDB, dueRemindersQuery+
+			"ORDER BY r.remind_at, e.id, r.offset_minutes LIMIT $2 FOR UPDATE OF r SKIP LOCKED",
+			now, limit); err != nil {
+			return fmt.Errorf("cant do select: %w", err)
+		}
+
+		due := make([]*storage.DueReminder, 0, len(remindersDB))
+		for _, item := range remindersDB {
+			event, reminder := item.ToApp()
+
+			check, err := event.CheckReminder(reminder, now, maxDelay)
+			if err != nil {
+				return fmt.Errorf("cant check reminder: %w", err)
+			}
+
+			if check.Skipped {
+				skipped++
+			}
+
+			if check.Due != nil {
+				due = append(due, check.Due)
+			}
+
+			_, err = tx.Exec(ctx, "UPDATE event_reminders SET remind_at = $1 WHERE event_id = $2 AND offset_minutes = $3",
+				nullTime(check.RemindAt), reminder.EventID, item.OffsetMinutes)
+			if err != nil {
+				return fmt.Errorf("exec error: %w", err)
+			}
+		}
+
+		due = storage.SortDueReminders(due, limit)
+		for _, r := range due {
+			_, err := tx.Exec(ctx, "UPDATE event_reminders SET locked_until = $1 "+
+				"WHERE event_id = $2 AND offset_minutes = $3", now.Add(lease), r.EventID, int64(r.Offset/time.Minute))
+			if err != nil {
+				return fmt.Errorf("exec error: %w", err)
+			}
+		}
+
+		reminders = due
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return reminders, skipped, nil
+}
+
+// UpdateReminderAsProcessed stores the reminder is sent for its occurrence along with outbox messages about it
+// in one transaction, messages with keys stored before are skipped.
+func (s *Storage) UpdateReminderAsProcessed(ctx context.Context, reminder *storage.DueReminder,
+	outbox []*storage.OutboxMessage) error {
+	return s.pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "UPDATE event_reminders SET locked_until = NULL, "+
+			"last_occurrence = GREATEST(last_occurrence, $1) WHERE event_id = $2 AND offset_minutes = $3",
+			reminder.Occurrence.Start, reminder.EventID, int64(reminder.Offset/time.Minute))
+		if err != nil {
+			return fmt.Errorf("exec error: %w", err)
+		}
+
+		if err := refreshRemindAt(ctx, tx, reminder.EventID, reminder.Offset); err != nil {
+			return err
+		}
+
+		for _, m := range outbox {
+			_, err = tx.Exec(ctx, "INSERT INTO outbox(dedup_key, payload) VALUES ($1, $2) "+
+				"ON CONFLICT (dedup_key) DO NOTHING", m.Key, m.Payload)
+			if err != nil {
+				return fmt.Errorf("exec error: %w", err)
+			}
+		}
+
+		return refreshProcessed(ctx, tx, reminder.EventID)
+	})
+}
+
+// ClaimOutbox returns unsent outbox messages not claimed by others in order of ids
+// and leases them till now + lease.
+func (s *Storage) ClaimOutbox(ctx context.Context, now time.Time, lease time.Duration, limit int64) (
+	[]*storage.OutboxMessage,
+	error) {
+	var messagesDB []OutboxMessage
+	if err := pgxscan.Select(ctx, s.pool, &messagesDB,
+		"UPDATE outbox SET locked_until = $2 WHERE id IN (SELECT id FROM outbox WHERE date_sent IS NULL "+
+			"AND (locked_until IS NULL OR locked_until <= $1) ORDER BY id LIMIT $3 FOR UPDATE SKIP LOCKED) "+
+			"RETURNING *", now, now.Add(lease), limit); err != nil {
+		return nil, fmt.Errorf("cant do update: %w", err)
+	}
+
+	sort.Slice(messagesDB, func(i, j int) bool {
+		return messagesDB[i].ID < messagesDB[j].ID
+	})
+
+	messages := make([]*storage.OutboxMessage, 0, len(messagesDB))
+	for _, item := range messagesDB {
+		message := item.ToApp()
+		messages = append(messages, &message)
+	}
+
+	return messages, nil
+}
+
+func (s *Storage) UpdateOutboxAsSent(ctx context.Context, id int64) error {
+	res, err := s.pool.Exec(ctx, "UPDATE outbox SET date_sent = CURRENT_TIMESTAMP, locked_until = NULL WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("exec error: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return storage.ErrOutboxMessageNotFound
+	}
+
+	return nil
+}
+
+func (s *Storage) GetAttendees(ctx context.Context, eventID string) ([]*storage.Attendee, error) {
+	var attendeesDB []Attendee
+	if err := pgxscan.Select(ctx, s.pool, &attendeesDB,
+		"SELECT * FROM event_attendees WHERE event_id = $1 ORDER BY user_id", eventID); err != nil {
+		return nil, fmt.Errorf("cant do select: %w", err)
+	}
+
+	attendees := make([]*storage.Attendee, 0, len(attendeesDB))
+	for _, item := range attendeesDB {
+		attendee := item.ToApp()
+		attendees = append(attendees, &attendee)
+	}
+
+	return attendees, nil
+}
+
+func (s *Storage) SaveAttendee(ctx context.Context, attendee *storage.Attendee) error {
+	_, err := s.pool.Exec(ctx, "INSERT INTO event_attendees(event_id, user_id, email, role, status, date_update) "+
+		"VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (event_id, user_id) DO UPDATE "+
+		"SET email=EXCLUDED.email, role=EXCLUDED.role, status=EXCLUDED.status, date_update=EXCLUDED.date_update",
+		attendee.EventID, attendee.UserID, attendee.Email, string(attendee.Role), string(attendee.Status),
+		nullTime(attendee.UpdatedAt))
 	if err != nil {
 		return fmt.Errorf("exec error: %w", err)
 	}
 
 	return nil
 }
+
+func (s *Storage) DeleteAttendee(ctx context.Context, eventID, userID string) error {
+	res, err := s.pool.Exec(ctx, "DELETE FROM event_attendees WHERE event_id=$1 AND user_id=$2", eventID, userID)
+	if err != nil {
+		return fmt.Errorf("exec error: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return calendar.ErrAttendeeNotFound
+	}
+
+	return nil
+}
+
+// columns copied to the archive, lease and schedule of reminders are not archived.
+const (
+	archivedEventColumns = "id, user_id, title, description, datetime_start, datetime_finish, all_day, time_zone, " +
+		"rrule, exdates, recurring_event_id, recurrence_id, processed, date_add, date_update"
+	archivedAttendeeColumns = "event_id, user_id, email, role, status, date_update"
+	archivedReminderColumns = "event_id, offset_minutes, last_occurrence"
+)
+
+// dueRemindersQuery selects reminders with time to check them before $1 not leased by other schedulers.
+const dueRemindersQuery = "SELECT e.*, r.offset_minutes, r.last_occurrence, r.remind_at FROM events e " +
+	"JOIN event_reminders r ON r.event_id = e.id WHERE r.remind_at <= $1 " +
+	"AND (r.locked_until IS NULL OR r.locked_until <= $1) "
+
+// saveReminders replaces reminders of the event with the offsets, state of reminders with the same offsets is kept.
+func saveReminders(ctx context.Context, tx pgx.Tx, eventID string, offsets []time.Duration) error {
+	minutes := make([]int64, 0, len(offsets))
+	for _, offset := range offsets {
+		minutes = append(minutes, int64(offset/time.Minute))
+	}
+
+	_, err := tx.Exec(ctx, "DELETE FROM event_reminders WHERE event_id = $1 AND NOT offset_minutes = ANY($2)",
+		eventID, minutes)
+	if err != nil {
+		return fmt.Errorf("exec error: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_reminders(event_id, offset_minutes) "+
+		"SELECT $1, unnest($2::integer[]) ON CONFLICT DO NOTHING", eventID, minutes)
+	if err != nil {
+		return fmt.Errorf("exec error: %w", err)
+	}
+
+	for _, offset := range offsets {
+		if err := refreshRemindAt(ctx, tx, eventID, offset); err != nil {
+			return err
+		}
+	}
+
+	return refreshProcessed(ctx, tx, eventID)
+}
+
+// refreshRemindAt sets time to check the reminder at by the occurrence it was sent for last.
+func refreshRemindAt(ctx context.Context, tx pgx.Tx, eventID string, offset time.Duration) error {
+	var eventDB Event
+	if err := pgxscan.Get(ctx, tx, &eventDB, "SELECT * FROM events WHERE id = $1", eventID); err != nil {
+		return fmt.Errorf("cant do select: %w", err)
+	}
+
+	var reminderDB Reminder
+	if err := pgxscan.Get(ctx, tx, &reminderDB, "SELECT * FROM event_reminders WHERE event_id = $1 "+
+		"AND offset_minutes = $2", eventID, int64(offset/time.Minute)); err != nil {
+		return fmt.Errorf("cant do select: %w", err)
+	}
+
+	event, reminder := eventDB.ToApp(), reminderDB.ToApp()
+
+	remindAt, err := event.NextRemindAt(reminder, reminder.LastOccurrence)
+	if err != nil {
+		return fmt.Errorf("cant get time to remind: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, "UPDATE event_reminders SET remind_at = $1 WHERE event_id = $2 AND offset_minutes = $3",
+		nullTime(remindAt), eventID, reminderDB.OffsetMinutes)
+	if err != nil {
+		return fmt.Errorf("exec error: %w", err)
+	}
+
+	return nil
+}
+
+// refreshProcessed marks single event as processed when all its reminders are sent for its current start.
+func refreshProcessed(ctx context.Context, tx pgx.Tx, eventID string) error {
+	_, err := tx.Exec(ctx, "UPDATE events e SET processed = e.rrule = '' "+
+		"AND EXISTS (SELECT 1 FROM event_reminders r WHERE r.event_id = e.id) "+
+		"AND NOT EXISTS (SELECT 1 FROM event_reminders r WHERE r.event_id = e.id "+
+		"AND (r.last_occurrence IS NULL OR r.last_occurrence < e.datetime_start)) WHERE e.id = $1", eventID)
+	if err != nil {
+		return fmt.Errorf("exec error: %w", err)
+	}
+
+	return nil
+}
+
+func timeZone(event *storage.Event) string {
+	if event.TimeZone == "" {
+		return storage.DefaultTimeZone
+	}
+
+	return event.TimeZone
+}
+
+func exDates(event *storage.Event) []time.Time {
+	if event.ExDates == nil {
+		return []time.Time{}
+	}
+
+	return event.ExDates
+}
+
+func nullString(v string) *string {
+	if v == "" {
+		return nil
+	}
+
+	return &v
+}
+
+func nullTime(v time.Time) *time.Time {
+	if v.IsZero() {
+		return nil
+	}
+
+	return &v
+}
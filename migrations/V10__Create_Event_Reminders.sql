@@ -0,0 +1,9 @@
+CREATE TABLE event_reminders (
+    event_id uuid NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    offset_minutes INTEGER NOT NULL CHECK (offset_minutes >= 0),
+    last_occurrence TIMESTAMPTZ,
+    PRIMARY KEY (event_id, offset_minutes)
+);
+
+INSERT INTO event_reminders (event_id, offset_minutes, last_occurrence)
+SELECT id, 0, CASE WHEN processed THEN datetime_start END FROM events;
@@ -0,0 +1 @@
+ALTER TABLE event_reminders ADD COLUMN locked_until TIMESTAMPTZ;
@@ -0,0 +1,10 @@
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    dedup_key VARCHAR NOT NULL UNIQUE,
+    payload JSONB NOT NULL,
+    date_add TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_until TIMESTAMPTZ,
+    date_sent TIMESTAMPTZ
+);
+
+CREATE INDEX outbox_unsent_idx ON outbox (id) WHERE date_sent IS NULL;
@@ -0,0 +1,7 @@
+CREATE TABLE events_archive (LIKE events INCLUDING DEFAULTS);
+
+ALTER TABLE events_archive
+    ADD COLUMN date_archive TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD PRIMARY KEY (id);
+
+CREATE INDEX events_datetime_finish_idx ON events (datetime_finish) WHERE rrule = '';
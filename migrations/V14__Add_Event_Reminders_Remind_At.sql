@@ -0,0 +1,8 @@
+ALTER TABLE event_reminders ADD COLUMN remind_at TIMESTAMPTZ;
+
+-- starts of events are the earliest time their reminders may be due, the scheduler moves it to later occurrences
+UPDATE event_reminders r SET remind_at = e.datetime_start - r.offset_minutes * interval '1 minute'
+FROM events e
+WHERE e.id = r.event_id AND (e.rrule <> '' OR r.last_occurrence IS NULL OR r.last_occurrence < e.datetime_start);
+
+CREATE INDEX event_reminders_remind_at_idx ON event_reminders (remind_at);
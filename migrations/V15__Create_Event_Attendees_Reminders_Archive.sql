@@ -0,0 +1,12 @@
+CREATE TABLE event_attendees_archive (LIKE event_attendees INCLUDING DEFAULTS);
+
+ALTER TABLE event_attendees_archive
+    ADD PRIMARY KEY (event_id, user_id),
+    ADD FOREIGN KEY (event_id) REFERENCES events_archive (id) ON DELETE CASCADE;
+
+CREATE TABLE event_reminders_archive (
+    event_id uuid NOT NULL REFERENCES events_archive (id) ON DELETE CASCADE,
+    offset_minutes INTEGER NOT NULL,
+    last_occurrence TIMESTAMPTZ,
+    PRIMARY KEY (event_id, offset_minutes)
+);
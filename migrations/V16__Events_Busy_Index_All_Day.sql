@@ -0,0 +1,2 @@
+DROP INDEX events_busy_idx;
+CREATE INDEX events_busy_idx ON events USING GIST (tstzrange(datetime_start, datetime_finish)) WHERE rrule = '';
@@ -0,0 +1,3 @@
+ALTER TABLE events ADD COLUMN rrule VARCHAR NOT NULL DEFAULT '';
+
+CREATE INDEX events_recurring_datetime_start_idx ON events (datetime_start) WHERE rrule <> '';
@@ -0,0 +1,6 @@
+ALTER TABLE events
+    ADD COLUMN exdates TIMESTAMP[] NOT NULL DEFAULT '{}',
+    ADD COLUMN recurring_event_id uuid REFERENCES events (id) ON DELETE CASCADE,
+    ADD COLUMN recurrence_id TIMESTAMP;
+
+CREATE UNIQUE INDEX events_recurring_event_id_recurrence_id_idx ON events (recurring_event_id, recurrence_id);
@@ -0,0 +1,3 @@
+ALTER TABLE events ADD COLUMN user_id VARCHAR NOT NULL DEFAULT '';
+
+CREATE INDEX events_user_id_datetime_start_idx ON events (user_id, datetime_start);
@@ -0,0 +1,14 @@
+ALTER TABLE events
+    ADD COLUMN time_zone VARCHAR NOT NULL DEFAULT 'UTC',
+    ALTER COLUMN datetime_start TYPE TIMESTAMPTZ USING datetime_start AT TIME ZONE 'UTC',
+    ALTER COLUMN datetime_finish TYPE TIMESTAMPTZ USING datetime_finish AT TIME ZONE 'UTC',
+    ALTER COLUMN recurrence_id TYPE TIMESTAMPTZ USING recurrence_id AT TIME ZONE 'UTC',
+    ALTER COLUMN date_add TYPE TIMESTAMPTZ USING date_add AT TIME ZONE 'UTC',
+    ADD COLUMN exdates_tz TIMESTAMPTZ[] NOT NULL DEFAULT '{}';
+
+UPDATE events
+SET exdates_tz = ARRAY(SELECT d AT TIME ZONE 'UTC' FROM unnest(exdates) AS d)
+WHERE cardinality(exdates) > 0;
+
+ALTER TABLE events DROP COLUMN exdates;
+ALTER TABLE events RENAME COLUMN exdates_tz TO exdates;
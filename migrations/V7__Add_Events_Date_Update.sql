@@ -0,0 +1 @@
+ALTER TABLE events ADD COLUMN date_update TIMESTAMPTZ;
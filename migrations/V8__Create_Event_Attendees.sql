@@ -0,0 +1,11 @@
+CREATE TABLE event_attendees (
+    event_id uuid NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    user_id VARCHAR NOT NULL,
+    email VARCHAR NOT NULL DEFAULT '',
+    role VARCHAR NOT NULL,
+    status VARCHAR NOT NULL,
+    date_update TIMESTAMPTZ,
+    PRIMARY KEY (event_id, user_id)
+);
+
+CREATE INDEX event_attendees_user_id_idx ON event_attendees (user_id);
@@ -18,15 +18,23 @@ import (
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/seregproj/calendar/api/proto"
 	"github.com/seregproj/calendar/internal/app/calendar"
+	internalgrpc "github.com/seregproj/calendar/internal/server/grpc"
 	"github.com/seregproj/calendar/internal/storage"
 	sqlstorage "github.com/seregproj/calendar/internal/storage/sql"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// api keys of docker-compose.test.yml.
+const (
+	testAPIKey  = "integration-tests-key"
+	otherAPIKey = "other-key"
+)
+
 type EventsSuite struct {
 	suite.Suite
 	db          *pgxpool.Pool
@@ -35,7 +43,7 @@ type EventsSuite struct {
 }
 
 func (s *EventsSuite) SetupSuite() {
-	s.ctx = context.Background()
+	s.ctx = metadata.AppendToOutgoingContext(context.Background(), internalgrpc.APIKeyMetadataKey, testAPIKey)
 	grpcConn, err := grpc.Dial(net.JoinHostPort(os.Getenv("GRPC_HOST"), os.Getenv("GRPC_PORT")), grpc.WithInsecure())
 	s.Require().NoError(err)
 
@@ -47,13 +55,14 @@ func (s *EventsSuite) SetupSuite() {
 }
 
 func (s *EventsSuite) TearDownTest() {
-	_, err := s.db.Exec(s.ctx, "TRUNCATE events")
+	_, err := s.db.Exec(s.ctx, "TRUNCATE events CASCADE")
 	s.Require().NoError(err)
 }
 
 func (s *EventsSuite) TestCreateEventSimple() {
 	event1 := getRandEvent(time.Now().Add(time.Minute*2), time.Now().Add(time.Minute*5))
 	event2 := getRandEvent(time.Now().Add(time.Minute*3), time.Now().Add(time.Minute*7))
+	event2.AllowOverlap = true
 
 	resp1, err := s.eventClient.CreateEvent(s.ctx, event1)
 	s.Require().NoError(err)
@@ -182,6 +191,7 @@ func (s *EventsSuite) TestDeleteEventSimple() {
 	s.Require().NoError(err)
 
 	event2 := getRandEvent(time.Now().Add(time.Minute*3), time.Now().Add(time.Minute*4))
+	event2.AllowOverlap = true
 	resp2, err := s.eventClient.CreateEvent(s.ctx, event2)
 	s.Require().NoError(err)
 
@@ -245,32 +255,308 @@ func (s *EventsSuite) TestGetEventsByDaySimple() {
 	_, err = s.eventClient.CreateEvent(s.ctx, eventNextDay)
 	s.Require().NoError(err)
 
+	// the rest of events overlap deliberately
 	eventDayBefore := getRandEvent(dayFromTime.AddDate(0, 0, -1), dayFromTime.AddDate(0, 0, 2))
+	eventDayBefore.AllowOverlap = true
 	_, err = s.eventClient.CreateEvent(s.ctx, eventDayBefore)
 	s.Require().NoError(err)
 
-	eventCorrect2 := getRandEvent(dayFromTime, dayFromTime.AddDate(0, 0, 5))
+	eventCorrect2 := getRandEvent(dayFromTime.Add(time.Hour), dayFromTime.AddDate(0, 0, 5))
+	eventCorrect2.AllowOverlap = true
 	_, err = s.eventClient.CreateEvent(s.ctx, eventCorrect2)
 	s.Require().NoError(err)
 
-	eventCorrect3 := getRandEvent(dayFromTime, dayFromTime.AddDate(0, 2, 0))
+	eventCorrect3 := getRandEvent(dayFromTime.Add(time.Hour*2), dayFromTime.AddDate(0, 2, 0))
+	eventCorrect3.AllowOverlap = true
 	_, err = s.eventClient.CreateEvent(s.ctx, eventCorrect3)
 	s.Require().NoError(err)
 
-	// limit = 2, offset 0, check first and second correct events
+	// limit = 2, offset 0, check event started the day before and the first correct event
 	resp, err := s.eventClient.GetEventsByDay(s.ctx, &proto.GetEventsByDayRequest{Day: dayFrom, Limit: 2})
 	s.Require().NoError(err)
 	s.Require().Equal(2, len(resp.GetItems()))
+	s.Require().True(eventPbExists(resp, eventDayBefore))
 	s.Require().True(eventPbExists(resp, eventCorrect1))
-	s.Require().True(eventPbExists(resp, eventCorrect2))
 
-	// offset = 2, check third correct event
+	// offset = 2, check second and third correct events
 	resp, err = s.eventClient.GetEventsByDay(s.ctx, &proto.GetEventsByDayRequest{Day: dayFrom, Limit: 10, Offset: 2})
 	s.Require().NoError(err)
-	s.Require().Equal(1, len(resp.GetItems()))
+	s.Require().Equal(2, len(resp.GetItems()))
+	s.Require().True(eventPbExists(resp, eventCorrect2))
 	s.Require().True(eventPbExists(resp, eventCorrect3))
 }
 
+func (s *EventsSuite) TestGetEventsByDayAllDay() {
+	newYork, err := time.LoadLocation("America/New_York")
+	s.Require().NoError(err)
+
+	// the event lasts two days in New York and is shown at the same dates in other time zones
+	dayFromTime, err := time.ParseInLocation("2006-01-02", time.Now().AddDate(0, 0, 15).Format("2006-01-02"), newYork)
+	s.Require().NoError(err)
+
+	event := getRandEvent(dayFromTime, dayFromTime.AddDate(0, 0, 2))
+	event.AllDay = true
+	event.TimeZone = "America/New_York"
+	_, err = s.eventClient.CreateEvent(s.ctx, event)
+	s.Require().NoError(err)
+
+	for _, tz := range []string{"", "Asia/Tokyo"} {
+		resp, err := s.eventClient.GetEventsByDay(s.ctx,
+			&proto.GetEventsByDayRequest{Day: dayFromTime.AddDate(0, 0, 1).Format("2006-01-02"), Limit: 10, TimeZone: tz})
+		s.Require().NoError(err)
+		s.Require().Equal(1, len(resp.GetItems()))
+		s.Require().True(resp.GetItems()[0].GetAllDay())
+
+		resp, err = s.eventClient.GetEventsByDay(s.ctx,
+			&proto.GetEventsByDayRequest{Day: dayFromTime.AddDate(0, 0, 2).Format("2006-01-02"), Limit: 10, TimeZone: tz})
+		s.Require().NoError(err)
+		s.Require().Equal(0, len(resp.GetItems()))
+	}
+}
+
+func (s *EventsSuite) TestListEventsPages() {
+	dayFrom := time.Now().AddDate(0, 0, 15).Format("2006-01-02")
+	dayFromTime, err := time.Parse("2006-01-02", dayFrom)
+	s.Require().NoError(err)
+
+	created := make([]*proto.Event, 0, 3)
+	for i := 0; i < 3; i++ {
+		event := getRandEvent(dayFromTime.AddDate(0, 0, i), dayFromTime.AddDate(0, 0, i).Add(time.Hour))
+		_, err = s.eventClient.CreateEvent(s.ctx, event)
+		s.Require().NoError(err)
+
+		created = append(created, event)
+	}
+
+	req := &proto.ListEventsRequest{
+		From:     timestamppb.New(dayFromTime),
+		To:       timestamppb.New(dayFromTime.AddDate(0, 0, 7)),
+		PageSize: 2,
+	}
+	resp, err := s.eventClient.ListEvents(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(2, len(resp.GetItems()))
+	s.Require().NotEmpty(resp.GetNextPageToken())
+
+	// events inserted before the cursor dont shift the next page
+	inserted := getRandEvent(dayFromTime, dayFromTime.Add(time.Minute))
+	inserted.AllowOverlap = true
+	_, err = s.eventClient.CreateEvent(s.ctx, inserted)
+	s.Require().NoError(err)
+
+	req.PageToken = resp.GetNextPageToken()
+	resp, err = s.eventClient.ListEvents(s.ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(resp.GetItems()))
+	s.Require().True(eventPbExists(&proto.Events{Items: resp.GetItems()}, created[2]))
+	s.Require().Empty(resp.GetNextPageToken())
+
+	week, err := s.eventClient.ListEventsByWeek(s.ctx, &proto.ListEventsByPeriodRequest{Day: dayFrom})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(week.GetItems())
+
+	_, err = s.eventClient.ListEvents(s.ctx, &proto.ListEventsRequest{
+		From: req.GetTo(), To: req.GetFrom(),
+	})
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.InvalidArgument, st.Code())
+}
+
+func (s *EventsSuite) TestGetEventFromList() {
+	dayFrom := time.Now().AddDate(0, 0, 15).Format("2006-01-02")
+	dayFromTime, err := time.Parse("2006-01-02", dayFrom)
+	s.Require().NoError(err)
+
+	event := getRandEvent(dayFromTime.Add(time.Hour), dayFromTime.Add(time.Hour*2))
+	resp, err := s.eventClient.CreateEvent(s.ctx, event)
+	s.Require().NoError(err)
+
+	events, err := s.eventClient.GetEventsByDay(s.ctx, &proto.GetEventsByDayRequest{Day: dayFrom, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(events.GetItems()))
+	s.Require().Equal(resp.GetUuid(), events.GetItems()[0].GetId())
+
+	got, err := s.eventClient.GetEvent(s.ctx, &proto.GetEventRequest{Uuid: events.GetItems()[0].GetId()})
+	s.Require().NoError(err)
+	s.Require().Equal(event.GetTitle(), got.GetTitle())
+	s.Require().False(got.GetProcessed())
+	s.Require().True(got.GetCreatedAt().IsValid())
+	s.Require().Nil(got.GetUpdatedAt())
+
+	_, err = s.eventClient.UpdateEvent(s.ctx, &proto.UpdateEventRequest{Uuid: resp.GetUuid(), Event: event})
+	s.Require().NoError(err)
+
+	got, err = s.eventClient.GetEvent(s.ctx, &proto.GetEventRequest{Uuid: resp.GetUuid()})
+	s.Require().NoError(err)
+	s.Require().NotNil(got.GetUpdatedAt())
+
+	otherCtx := metadata.AppendToOutgoingContext(context.Background(), internalgrpc.APIKeyMetadataKey, otherAPIKey)
+	_, err = s.eventClient.GetEvent(otherCtx, &proto.GetEventRequest{Uuid: resp.GetUuid()})
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.NotFound, st.Code())
+}
+
+func (s *EventsSuite) TestAttendeeInvitation() {
+	dayFromTime := time.Now().AddDate(0, 0, 15)
+	event := getRandEvent(dayFromTime, dayFromTime.Add(time.Hour))
+	resp, err := s.eventClient.CreateEvent(s.ctx, event)
+	s.Require().NoError(err)
+
+	otherCtx := metadata.AppendToOutgoingContext(context.Background(), internalgrpc.APIKeyMetadataKey, otherAPIKey)
+
+	// not invited user can not respond
+	_, err = s.eventClient.RespondToInvitation(otherCtx, &proto.RespondToInvitationRequest{
+		Uuid: resp.GetUuid(), Status: proto.RsvpStatus_RSVP_STATUS_ACCEPTED,
+	})
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.NotFound, st.Code())
+
+	_, err = s.eventClient.InviteAttendee(s.ctx, &proto.InviteAttendeeRequest{
+		Uuid: resp.GetUuid(), Attendee: &proto.Attendee{UserId: "other", Role: proto.AttendeeRole_ATTENDEE_ROLE_OPTIONAL},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.eventClient.RespondToInvitation(otherCtx, &proto.RespondToInvitationRequest{
+		Uuid: resp.GetUuid(), Status: proto.RsvpStatus_RSVP_STATUS_TENTATIVE,
+	})
+	s.Require().NoError(err)
+
+	// invited user sees the event with its attendees
+	got, err := s.eventClient.GetEvent(otherCtx, &proto.GetEventRequest{Uuid: resp.GetUuid()})
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(got.GetAttendees()))
+	s.Require().Equal("other", got.GetAttendees()[0].GetUserId())
+	s.Require().Equal(proto.AttendeeRole_ATTENDEE_ROLE_OPTIONAL, got.GetAttendees()[0].GetRole())
+	s.Require().Equal(proto.RsvpStatus_RSVP_STATUS_TENTATIVE, got.GetAttendees()[0].GetStatus())
+
+	_, err = s.eventClient.RemoveAttendee(s.ctx, &proto.RemoveAttendeeRequest{Uuid: resp.GetUuid(), UserId: "other"})
+	s.Require().NoError(err)
+
+	_, err = s.eventClient.GetEvent(otherCtx, &proto.GetEventRequest{Uuid: resp.GetUuid()})
+	st, ok = status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.NotFound, st.Code())
+}
+
+func (s *EventsSuite) TestCreateEventConflict() {
+	dayFromTime := time.Now().AddDate(0, 0, 15)
+	event := getRandEvent(dayFromTime, dayFromTime.Add(time.Hour))
+	resp, err := s.eventClient.CreateEvent(s.ctx, event)
+	s.Require().NoError(err)
+
+	overlapping := getRandEvent(dayFromTime.Add(time.Minute*30), dayFromTime.Add(time.Hour*2))
+	_, err = s.eventClient.CreateEvent(s.ctx, overlapping)
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.FailedPrecondition, st.Code())
+	s.Require().Contains(st.Message(), resp.GetUuid())
+
+	// the event does not conflict with itself on update
+	event.Title = faker.Word()
+	_, err = s.eventClient.UpdateEvent(s.ctx, &proto.UpdateEventRequest{Uuid: resp.GetUuid(), Event: event})
+	s.Require().NoError(err)
+
+	// adjacent event and deliberate double booking are allowed
+	adjacent := getRandEvent(dayFromTime.Add(time.Hour), dayFromTime.Add(time.Hour*2))
+	_, err = s.eventClient.CreateEvent(s.ctx, adjacent)
+	s.Require().NoError(err)
+
+	overlapping.AllowOverlap = true
+	_, err = s.eventClient.CreateEvent(s.ctx, overlapping)
+	s.Require().NoError(err)
+}
+
+func (s *EventsSuite) TestFreeBusy() {
+	dayFromTime := time.Now().AddDate(0, 0, 15).Truncate(time.Hour)
+
+	overlapping := getRandEvent(dayFromTime.Add(time.Minute*30), dayFromTime.Add(time.Hour*2))
+	overlapping.AllowOverlap = true
+
+	for _, e := range []*proto.Event{
+		getRandEvent(dayFromTime, dayFromTime.Add(time.Hour)),
+		overlapping,
+		getRandEvent(dayFromTime.Add(time.Hour*3), dayFromTime.Add(time.Hour*4)),
+	} {
+		_, err := s.eventClient.CreateEvent(s.ctx, e)
+		s.Require().NoError(err)
+	}
+
+	otherCtx := metadata.AppendToOutgoingContext(context.Background(), internalgrpc.APIKeyMetadataKey, otherAPIKey)
+	resp, err := s.eventClient.FreeBusy(otherCtx, &proto.FreeBusyRequest{
+		UserIds: []string{"integration-tests", "other"},
+		From:    timestamppb.New(dayFromTime.Add(time.Hour)),
+		To:      timestamppb.New(dayFromTime.AddDate(0, 0, 1)),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(2, len(resp.GetUsers()))
+
+	busy := resp.GetUsers()[0].GetBusy()
+	s.Require().Equal(2, len(busy))
+	s.Require().Equal(dayFromTime.Add(time.Hour).UTC(), busy[0].GetStart().AsTime())
+	s.Require().Equal(dayFromTime.Add(time.Hour*2).UTC(), busy[0].GetFinish().AsTime())
+	s.Require().Equal(dayFromTime.Add(time.Hour*3).UTC(), busy[1].GetStart().AsTime())
+	s.Require().Equal(0, len(resp.GetUsers()[1].GetBusy()))
+}
+
+func (s *EventsSuite) TestFindAvailableSlots() {
+	// the same weekday two weeks later
+	dayFromTime, err := time.Parse("2006-01-02", time.Now().AddDate(0, 0, 14).Format("2006-01-02"))
+	s.Require().NoError(err)
+
+	_, err = s.eventClient.CreateEvent(s.ctx, getRandEvent(dayFromTime.Add(time.Hour*9), dayFromTime.Add(time.Hour*17)))
+	s.Require().NoError(err)
+
+	resp, err := s.eventClient.FindAvailableSlots(s.ctx, &proto.FindAvailableSlotsRequest{
+		UserIds:           []string{"integration-tests", "other"},
+		DurationMinutes:   60,
+		From:              timestamppb.New(dayFromTime),
+		To:                timestamppb.New(dayFromTime.AddDate(0, 0, 1)),
+		WorkingHoursStart: "09:00",
+		WorkingHoursEnd:   "18:00",
+		WorkingDays: []proto.Weekday{
+			proto.Weekday_WEEKDAY_SUNDAY, proto.Weekday_WEEKDAY_MONDAY, proto.Weekday_WEEKDAY_TUESDAY,
+			proto.Weekday_WEEKDAY_WEDNESDAY, proto.Weekday_WEEKDAY_THURSDAY, proto.Weekday_WEEKDAY_FRIDAY,
+			proto.Weekday_WEEKDAY_SATURDAY,
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(resp.GetSlots()))
+	s.Require().Equal(dayFromTime.Add(time.Hour*17), resp.GetSlots()[0].GetStart().AsTime())
+}
+
+func (s *EventsSuite) TestEventsOfOtherUser() {
+	dayFrom := time.Now().AddDate(0, 0, 15).Format("2006-01-02")
+	dayFromTime, err := time.Parse("2006-01-02", dayFrom)
+	s.Require().NoError(err)
+
+	event := getRandEvent(dayFromTime.Add(time.Hour), dayFromTime.Add(time.Hour*5))
+	resp, err := s.eventClient.CreateEvent(s.ctx, event)
+	s.Require().NoError(err)
+
+	otherCtx := metadata.AppendToOutgoingContext(context.Background(), internalgrpc.APIKeyMetadataKey, otherAPIKey)
+
+	events, err := s.eventClient.GetEventsByDay(otherCtx, &proto.GetEventsByDayRequest{Day: dayFrom, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Equal(0, len(events.GetItems()))
+
+	_, err = s.eventClient.UpdateEvent(otherCtx, &proto.UpdateEventRequest{Uuid: resp.GetUuid(), Event: event})
+	st, ok := status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.InvalidArgument, st.Code())
+
+	_, err = s.eventClient.DeleteEvent(otherCtx, &proto.DeleteEventRequest{Uuid: resp.GetUuid()})
+	s.Require().NoError(err)
+	s.Require().Equal(event.GetTitle(), s.getEvent(resp.GetUuid()).Title)
+
+	_, err = s.eventClient.GetEventsByDay(context.Background(), &proto.GetEventsByDayRequest{Day: dayFrom, Limit: 10})
+	st, ok = status.FromError(err)
+	s.Require().True(ok)
+	s.Require().Equal(codes.Unauthenticated, st.Code())
+}
+
 func (s *EventsSuite) getEvent(uuid string) storage.Event {
 	var event sqlstorage.Event
 
@@ -0,0 +1,110 @@
+package pipeline_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/seregproj/calendar/internal/app/calendar"
+	"github.com/seregproj/calendar/internal/app/scheduler"
+	"github.com/seregproj/calendar/internal/app/sender"
+	"github.com/seregproj/calendar/internal/auth"
+	"github.com/seregproj/calendar/internal/channel"
+	"github.com/seregproj/calendar/internal/messagebroker"
+	memorybroker "github.com/seregproj/calendar/internal/messagebroker/memory"
+	"github.com/seregproj/calendar/internal/storage"
+	memorystorage "github.com/seregproj/calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Info(string)                                      {}
+func (nopLogger) Warning(string)                                   {}
+func (nopLogger) WarningWithFields(string, map[string]interface{}) {}
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func (c fixedClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// TestPipeline runs calendar, scheduler and sender in one process connected by the in-memory broker.
+func TestPipeline(t *testing.T) {
+	ctx := auth.ContextWithPrincipal(context.Background(), &auth.Principal{UserID: "user1", Method: auth.MethodAPIKey})
+	now := time.Date(2021, 5, 3, 10, 0, 0, 0, time.UTC)
+	s := memorystorage.New()
+
+	eventID, err := calendar.New(nopLogger{}, s, storage.NewUUIDGen()).CreateEvent(ctx, &storage.Event{
+		Title: "Standup", Start: now.Add(15 * time.Minute), Finish: now.Add(30 * time.Minute),
+		TimeZone: "UTC", Reminders: []time.Duration{15 * time.Minute},
+	})
+	require.NoError(t, err)
+
+	broker, err := memorybroker.New(messagebroker.RetryPolicy{MaxAttempts: 1})
+	require.NoError(t, err)
+
+	schedulerApp := scheduler.New(nopLogger{}, s, broker, fixedClock(now), time.Minute, storage.DefaultReminderDelay)
+	require.NoError(t, schedulerApp.ProcessActualEvents(ctx, 10))
+	require.NoError(t, scheduler.NewRelay(nopLogger{}, s, broker, fixedClock(now), time.Minute).RelayOutbox(ctx, 10))
+	require.Equal(t, 1, broker.Len())
+
+	path := filepath.Join(t.TempDir(), "notifications.jsonl")
+	file, err := channel.NewFile(path)
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	router, err := sender.NewRouter([]sender.Channel{file}, []string{"file"}, nil)
+	require.NoError(t, err)
+
+	templates, err := sender.LoadTemplates("../../templates", "en", "https://calendar/events/%s", router.Names())
+	require.NoError(t, err)
+
+	senderCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() {
+		done <- sender.New(nopLogger{}, broker, router, templates).SendNotifications(senderCtx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(readLines(t, path)) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	var got messagebroker.Notification
+	require.NoError(t, json.Unmarshal([]byte(readLines(t, path)[0]), &got))
+	require.Equal(t, eventID, got.EventID)
+	require.Equal(t, "user1", got.UserID)
+	require.Equal(t, 0, broker.Len())
+	require.Empty(t, broker.DeadLetters())
+}
+
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+
+	defer f.Close()
+
+	var lines []string
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	return lines
+}